@@ -0,0 +1,199 @@
+// Copyright 2022 phelmkamp. All rights reserved.
+// Use of this source code is governed by a MIT
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"go/ast"
+	"go/constant"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"path/filepath"
+	"sort"
+	"strings"
+	"text/template"
+	"unicode"
+
+	"github.com/binaryphile/valor/result"
+)
+
+type (
+	// enumSpec describes the enum to generate for a string type.
+	enumSpec struct {
+		Package string
+		Type    string
+		Title   string // Type with the first letter in upper case
+		Var     string // name of the enum variable
+		Parse   string // name of the parse function
+		Invalid string // a name that is not a value of the enum
+		Values  []enumValue
+	}
+
+	// enumValue is a constant of the type of an enum.
+	enumValue struct {
+		Name  string
+		Value string
+	}
+
+	// noImporter fails every import so that only local source is type-checked.
+	noImporter struct{}
+)
+
+// loadEnum finds the string type typeName and its constants
+// in the package in dir, ignoring the files named in skip.
+func loadEnum(dir, typeName string, skip ...string) result.Result[enumSpec] {
+	fset := token.NewFileSet()
+
+	files, err := parseDir(fset, dir, skip)
+	if err != nil {
+		return result.OfError[enumSpec](err)
+	}
+
+	if len(files) == 0 {
+		return result.OfError[enumSpec](fmt.Errorf("no Go files in %s", dir))
+	}
+
+	conf := types.Config{
+		Importer: noImporter{},
+		// Report nothing; errors unrelated to the type, such as failed imports, are expected.
+		Error: func(error) {},
+	}
+
+	pkg, _ := conf.Check(files[0].Name.Name, fset, files, nil)
+
+	typ, ok := pkg.Scope().Lookup(typeName).(*types.TypeName)
+	if !ok {
+		return result.OfError[enumSpec](fmt.Errorf("no type %s in %s", typeName, dir))
+	}
+
+	if basic, ok := typ.Type().Underlying().(*types.Basic); !ok || basic.Kind() != types.String {
+		return result.OfError[enumSpec](fmt.Errorf("type %s is not a string type", typeName))
+	}
+
+	consts := make([]*types.Const, 0)
+
+	for _, name := range pkg.Scope().Names() {
+		if c, ok := pkg.Scope().Lookup(name).(*types.Const); ok && types.Identical(c.Type(), typ.Type()) {
+			consts = append(consts, c)
+		}
+	}
+
+	if len(consts) == 0 {
+		return result.OfError[enumSpec](fmt.Errorf("no constants of type %s in %s", typeName, dir))
+	}
+
+	// Declaration order is the order of the enum.
+	// Positions increase through the files in the order they were parsed.
+	sort.Slice(consts, func(i, j int) bool {
+		return consts[i].Pos() < consts[j].Pos()
+	})
+
+	spec := enumSpec{
+		Package: pkg.Name(),
+		Type:    typeName,
+		Title:   title(typeName),
+		Var:     typeName + "Enum",
+		Parse:   exportAs(typeName, "Parse") + title(typeName),
+		Values:  make([]enumValue, len(consts)),
+	}
+
+	values := make(map[string]bool)
+
+	for i, c := range consts {
+		spec.Values[i] = enumValue{
+			Name:  c.Name(),
+			Value: constant.StringVal(c.Val()),
+		}
+		values[spec.Values[i].Value] = true
+	}
+
+	spec.Invalid = "invalid"
+	for values[spec.Invalid] {
+		spec.Invalid += "_"
+	}
+
+	return result.OfOk(spec)
+}
+
+// source returns the formatted source of the enum declaration and methods.
+func (x enumSpec) source() result.Result[[]byte] {
+	return execute(sourceTemplate, x)
+}
+
+// test returns the formatted source of the test of the methods.
+func (x enumSpec) test() result.Result[[]byte] {
+	return execute(testTemplate, x)
+}
+
+// Import fails.
+func (noImporter) Import(path string) (*types.Package, error) {
+	return nil, fmt.Errorf("valorgen: not importing %s", path)
+}
+
+// parseDir parses the non-test Go files in dir other than those named in skip.
+func parseDir(fset *token.FileSet, dir string, skip []string) ([]*ast.File, error) {
+	names, err := filepath.Glob(filepath.Join(dir, "*.go"))
+	if err != nil {
+		return nil, err
+	}
+
+	skipped := make(map[string]bool)
+	for _, name := range skip {
+		skipped[filepath.Clean(name)] = true
+	}
+
+	files := make([]*ast.File, 0, len(names))
+
+	for _, name := range names {
+		if strings.HasSuffix(name, "_test.go") || skipped[filepath.Clean(name)] {
+			continue
+		}
+
+		file, err := parser.ParseFile(fset, name, nil, parser.ParseComments)
+		if err != nil {
+			return nil, err
+		}
+
+		files = append(files, file)
+	}
+
+	return files, nil
+}
+
+func execute(tmpl *template.Template, spec enumSpec) result.Result[[]byte] {
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, spec); err != nil {
+		return result.OfError[[]byte](err)
+	}
+
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		return result.OfError[[]byte](errors.Join(fmt.Errorf("formatting generated %s", tmpl.Name()), err))
+	}
+
+	return result.OfOk(src)
+}
+
+// exportAs returns name with the case of its first letter matching that of ident.
+func exportAs(ident, name string) string {
+	if token.IsExported(ident) {
+		return title(name)
+	}
+
+	return string(unicode.ToLower(rune(name[0]))) + name[1:]
+}
+
+// title returns s with its first letter in upper case.
+func title(s string) string {
+	if s == "" {
+		return s
+	}
+
+	return string(unicode.ToUpper(rune(s[0]))) + s[1:]
+}
@@ -0,0 +1,91 @@
+// Copyright 2022 phelmkamp. All rights reserved.
+// Use of this source code is governed by a MIT
+// license that can be found in the LICENSE file.
+
+// Command valorgen generates code for valor types.
+//
+// Usage:
+//
+//	valorgen enum -type T [-dir dir] [-output file]
+//
+// The enum subcommand finds the string type T and the constants of type T
+// in the package in dir, then writes a file declaring an enum.Enum of the constants
+// along with accessors and JSON, text and database/sql methods for T.
+// It also writes a test of the methods alongside it.
+// It is intended to be run by go generate:
+//
+//	//go:generate valorgen enum -type Suit
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/binaryphile/valor/result"
+)
+
+var errUsage = errors.New("usage: valorgen enum -type T [-dir dir] [-output file]")
+
+func main() {
+	result.Main(func(context.Context) result.Result[int] {
+		return run(os.Args[1:], os.Stderr)
+	}, result.CodeIs(errUsage, 2))
+}
+
+// run runs the subcommand named by args[0] with the rest of args.
+func run(args []string, stderr io.Writer) result.Result[int] {
+	if len(args) == 0 || args[0] != "enum" {
+		return result.OfError[int](errUsage)
+	}
+
+	fs := flag.NewFlagSet("enum", flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	typeName := fs.String("type", "", "name of the string type")
+	dir := fs.String("dir", ".", "directory of the package")
+	output := fs.String("output", "", "output file (default dir/<type>_enum.go)")
+
+	if err := fs.Parse(args[1:]); err != nil {
+		return result.OfError[int](fmt.Errorf("%w: %v", errUsage, err))
+	}
+
+	if *typeName == "" || fs.NArg() > 0 {
+		return result.OfError[int](errUsage)
+	}
+
+	if *output == "" {
+		*output = filepath.Join(*dir, strings.ToLower(*typeName)+"_enum.go")
+	}
+
+	testOutput := strings.TrimSuffix(*output, ".go") + "_test.go"
+
+	spec, err := loadEnum(*dir, *typeName, *output, testOutput).Unpack()
+	if err != nil {
+		return result.OfError[int](err)
+	}
+
+	if err := write(*output, spec.source()); err != nil {
+		return result.OfError[int](err)
+	}
+
+	if err := write(testOutput, spec.test()); err != nil {
+		return result.OfError[int](err)
+	}
+
+	return result.OfOk(0)
+}
+
+// write writes the contents of res to the file named name.
+func write(name string, res result.Result[[]byte]) error {
+	src, err := res.Unpack()
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(name, src, 0o644)
+}
@@ -0,0 +1,153 @@
+// Copyright 2022 phelmkamp. All rights reserved.
+// Use of this source code is governed by a MIT
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// TestRun_golden checks that the generated files in testdata are up to date.
+// The generated test in testdata/cards verifies the generated methods:
+//
+//	go test ./cmd/valorgen/testdata/cards
+func TestRun_golden(t *testing.T) {
+	tmp := t.TempDir()
+	output := filepath.Join(tmp, "suit_enum.go")
+
+	if res := run([]string{"enum", "-type", "Suit", "-dir", "testdata/cards", "-output", output}, io.Discard); res.IsError() {
+		t.Fatalf("run() error = %v", res.Error())
+	}
+
+	for _, name := range []string{"suit_enum.go", "suit_enum_test.go"} {
+		got, err := os.ReadFile(filepath.Join(tmp, name))
+		if err != nil {
+			t.Fatal(err)
+		}
+		want, err := os.ReadFile(filepath.Join("testdata/cards", name))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Equal(got, want) {
+			t.Errorf("%s differs from testdata; regenerate with go run . enum -type Suit -dir testdata/cards", name)
+		}
+	}
+}
+
+func TestRun_generatedTest(t *testing.T) {
+	if testing.Short() {
+		t.Skip("runs go test")
+	}
+	goTool, err := exec.LookPath("go")
+	if err != nil {
+		t.Skip("go tool not found")
+	}
+
+	out, err := exec.Command(goTool, "test", "./testdata/cards").CombinedOutput()
+	if err != nil {
+		t.Errorf("go test ./testdata/cards error = %v\n%s", err, out)
+	}
+}
+
+func TestLoadEnum(t *testing.T) {
+	spec, err := loadEnum("testdata/cards", "rank").Unpack()
+	if err != nil {
+		t.Fatalf("loadEnum() error = %v", err)
+	}
+
+	want := enumSpec{
+		Package: "cards",
+		Type:    "rank",
+		Title:   "Rank",
+		Var:     "rankEnum",
+		Parse:   "parseRank",
+		Invalid: "invalid",
+		Values:  []enumValue{{"ace", "ace"}, {"king", "king"}},
+	}
+	if !reflect.DeepEqual(spec, want) {
+		t.Errorf("loadEnum() = %+v, want %+v", spec, want)
+	}
+
+	src, err := spec.test().Unpack()
+	if err != nil {
+		t.Fatalf("test() error = %v", err)
+	}
+	if !strings.Contains(string(src), "func TestRank_roundTrip(") {
+		t.Errorf("test() = %s, want TestRank_roundTrip", src)
+	}
+}
+
+func TestLoadEnum_errors(t *testing.T) {
+	dir := t.TempDir()
+	src := `package p
+
+type Count int
+
+type Name string
+
+type Empty string
+
+const Bob Name = "bob"
+`
+	if err := os.WriteFile(filepath.Join(dir, "p.go"), []byte(src), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		typeName string
+		want     string
+	}{
+		{"Missing", "no type Missing"},
+		{"Count", "not a string type"},
+		{"Empty", "no constants of type Empty"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.typeName, func(t *testing.T) {
+			err := loadEnum(dir, tt.typeName).Error()
+			if err == nil || !strings.Contains(err.Error(), tt.want) {
+				t.Errorf("loadEnum() error = %v, want %v", err, tt.want)
+			}
+		})
+	}
+
+	if err := loadEnum(t.TempDir(), "Name").Error(); err == nil {
+		t.Errorf("loadEnum() of empty dir error = %v, want error", err)
+	}
+}
+
+func TestLoadEnum_invalidName(t *testing.T) {
+	dir := t.TempDir()
+	src := `package p
+
+type Word string
+
+const (
+	Valid   Word = "valid"
+	Invalid Word = "invalid"
+)
+`
+	if err := os.WriteFile(filepath.Join(dir, "p.go"), []byte(src), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	if got := loadEnum(dir, "Word").Value().MustOk().Invalid; got != "invalid_" {
+		t.Errorf("loadEnum().Invalid = %v, want %v", got, "invalid_")
+	}
+}
+
+func TestRun_usage(t *testing.T) {
+	for _, args := range [][]string{nil, {"other"}, {"enum"}, {"enum", "-bogus"}, {"enum", "-type", "T", "extra"}} {
+		if err := run(args, io.Discard).Error(); !errors.Is(err, errUsage) {
+			t.Errorf("run(%q) error = %v, want %v", args, err, errUsage)
+		}
+	}
+}
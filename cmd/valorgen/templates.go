@@ -0,0 +1,212 @@
+// Copyright 2022 phelmkamp. All rights reserved.
+// Use of this source code is governed by a MIT
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"text/template"
+)
+
+var sourceTemplate = template.Must(template.New("source").Parse(`// Code generated by valorgen; DO NOT EDIT.
+
+package {{.Package}}
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+
+	"github.com/binaryphile/valor/enum"
+	"github.com/binaryphile/valor/optional"
+)
+
+// {{.Var}} is the enum of the declared {{.Type}} values.
+var {{.Var}}, _ = enum.Of[{{.Type}}, struct{}](
+{{- range .Values}}
+	{{.Name}},
+{{- end}}
+)
+
+// Member returns the member of {{.Var}} for x.
+// Returns a not-ok Value if x is not a declared {{.Type}}.
+func (x {{.Type}}) Member() optional.Value[enum.Member[{{.Type}}, struct{}]] {
+	return {{.Var}}.Member(string(x))
+}
+
+// IsValid returns whether x is a declared {{.Type}}.
+func (x {{.Type}}) IsValid() bool {
+	return {{.Var}}.Includes(string(x))
+}
+
+// {{.Parse}} returns the {{.Type}} named name.
+// It is an error if name is not a declared {{.Type}}.
+func {{.Parse}}(name string) ({{.Type}}, error) {
+	member, err := {{.Var}}.Parse(name).Unpack()
+	if err != nil {
+		return "", err
+	}
+
+	return {{.Type}}(member.Name()), nil
+}
+
+// MarshalText encodes x as its name.
+// It is an error if x is neither a declared {{.Type}} nor empty.
+func (x {{.Type}}) MarshalText() ([]byte, error) {
+	if x != "" && !x.IsValid() {
+		return nil, fmt.Errorf("%q: %w", string(x), enum.ErrNotMember)
+	}
+
+	return []byte(x), nil
+}
+
+// UnmarshalText decodes a name into x.
+// It is an error if the name is not a declared {{.Type}}.
+func (x *{{.Type}}) UnmarshalText(text []byte) error {
+	parsed, err := {{.Parse}}(string(text))
+	if err != nil {
+		return err
+	}
+
+	*x = parsed
+
+	return nil
+}
+
+// MarshalJSON encodes x as its name.
+// The empty {{.Type}} encodes as the literal null.
+func (x {{.Type}}) MarshalJSON() ([]byte, error) {
+	if x == "" {
+		return []byte("null"), nil
+	}
+
+	text, err := x.MarshalText()
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(string(text))
+}
+
+// UnmarshalJSON decodes a name into x.
+// Does nothing if data is the literal null.
+func (x *{{.Type}}) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		// by convention, null is no-op
+		return nil
+	}
+
+	var name string
+	if err := json.Unmarshal(data, &name); err != nil {
+		return err
+	}
+
+	return x.UnmarshalText([]byte(name))
+}
+
+// Value encodes x as its name for use as a query argument.
+// The empty {{.Type}} encodes as NULL.
+func (x {{.Type}}) Value() (driver.Value, error) {
+	if x == "" {
+		return nil, nil
+	}
+
+	text, err := x.MarshalText()
+	if err != nil {
+		return nil, err
+	}
+
+	return string(text), nil
+}
+
+// Scan decodes a name from a text column into x.
+// NULL decodes as the empty {{.Type}}.
+func (x *{{.Type}}) Scan(src any) error {
+	switch src := src.(type) {
+	case nil:
+		*x = ""
+
+		return nil
+	case string:
+		return x.UnmarshalText([]byte(src))
+	case []byte:
+		return x.UnmarshalText(src)
+	}
+
+	return fmt.Errorf("cannot scan %T into {{.Type}}", src)
+}
+`))
+
+var testTemplate = template.Must(template.New("test").Parse(`// Code generated by valorgen; DO NOT EDIT.
+
+package {{.Package}}
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/binaryphile/valor/enum"
+)
+
+func Test{{.Title}}_roundTrip(t *testing.T) {
+	for _, want := range []{{.Type}}{
+{{- range .Values}}
+		{{.Name}},
+{{- end}}
+	} {
+		b, err := json.Marshal(want)
+		if err != nil {
+			t.Fatalf("MarshalJSON() error = %v", err)
+		}
+		var got {{.Type}}
+		if err := json.Unmarshal(b, &got); err != nil || got != want {
+			t.Errorf("UnmarshalJSON(%s) = %v %v, want %v", b, got, err, want)
+		}
+
+		text, err := want.MarshalText()
+		if err != nil {
+			t.Fatalf("MarshalText() error = %v", err)
+		}
+		got = ""
+		if err := got.UnmarshalText(text); err != nil || got != want {
+			t.Errorf("UnmarshalText(%s) = %v %v, want %v", text, got, err, want)
+		}
+
+		v, err := want.Value()
+		if err != nil {
+			t.Fatalf("Value() error = %v", err)
+		}
+		got = ""
+		if err := got.Scan(v); err != nil || got != want {
+			t.Errorf("Scan(%v) = %v %v, want %v", v, got, err, want)
+		}
+
+		if member := want.Member(); !member.IsOk() || member.MustOk().Name() != string(want) {
+			t.Errorf("Member() = %v, want %v", member, want)
+		}
+	}
+}
+
+func Test{{.Title}}_invalid(t *testing.T) {
+	invalid := {{.Type}}({{printf "%q" .Invalid}})
+
+	if invalid.IsValid() {
+		t.Errorf("IsValid() = %v, want %v", true, false)
+	}
+	if _, err := json.Marshal(invalid); !errors.Is(err, enum.ErrNotMember) {
+		t.Errorf("MarshalJSON() error = %v, want %v", err, enum.ErrNotMember)
+	}
+
+	var got {{.Type}}
+	if err := json.Unmarshal([]byte({{printf "%q" (printf "%q" .Invalid)}}), &got); !errors.Is(err, enum.ErrNotMember) {
+		t.Errorf("UnmarshalJSON() error = %v, want %v", err, enum.ErrNotMember)
+	}
+	if err := got.Scan(string(invalid)); !errors.Is(err, enum.ErrNotMember) {
+		t.Errorf("Scan() error = %v, want %v", err, enum.ErrNotMember)
+	}
+	if _, err := {{.Parse}}(string(invalid)); !errors.Is(err, enum.ErrNotMember) {
+		t.Errorf("{{.Parse}}() error = %v, want %v", err, enum.ErrNotMember)
+	}
+}
+`))
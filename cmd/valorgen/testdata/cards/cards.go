@@ -0,0 +1,24 @@
+// Package cards is a fixture for the enum generator.
+package cards
+
+//go:generate valorgen enum -type Suit
+
+// Suit is the suit of a playing card.
+type Suit string
+
+const (
+	Clubs    Suit = "clubs"
+	Diamonds Suit = "diamonds"
+	Hearts   Suit = "hearts"
+	Spades   Suit = "spades"
+)
+
+// Joker is not a Suit, so it is not in the enum.
+const Joker = "joker"
+
+type rank string
+
+const (
+	ace  rank = "ace"
+	king rank = "king"
+)
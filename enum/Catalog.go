@@ -0,0 +1,105 @@
+package enum
+
+import (
+	"fmt"
+)
+
+type (
+	// Catalog pairs each member of an enum of error codes with a message.
+	Catalog[T ~string, A any] struct {
+		enum     Enum[T, A]
+		messages map[T]string
+	}
+
+	// Entry is a code in a Catalog along with its message.
+	Entry[T ~string, A any] struct {
+		Code    Member[T, A]
+		Message string
+	}
+
+	// Error is an error identified by a code.
+	// It matches its code with errors.Is and unwraps to its cause.
+	Error[T ~string, A any] struct {
+		Code    Member[T, A]
+		Message string
+		Cause   error
+	}
+)
+
+// CatalogOf creates a Catalog of the members of enum with messages.
+// Members without a message have an empty one.
+func CatalogOf[T ~string, A any](enum Enum[T, A], messages map[T]string) Catalog[T, A] {
+	return Catalog[T, A]{
+		enum:     enum,
+		messages: messages,
+	}
+}
+
+// Entries returns the codes of the catalog and their messages in enum order.
+// This aids in documenting the errors a package can return.
+func (x Catalog[T, A]) Entries() []Entry[T, A] {
+	names := x.enum.Names()
+	entries := make([]Entry[T, A], 0, len(names))
+
+	for _, name := range names {
+		code := x.enum.Member(name).MustOk()
+
+		entries = append(entries, Entry[T, A]{
+			Code:    code,
+			Message: x.messages[code.value],
+		})
+	}
+
+	return entries
+}
+
+// New returns an Error of code with its message.
+func (x Catalog[T, A]) New(code Member[T, A]) Error[T, A] {
+	return Error[T, A]{
+		Code:    code,
+		Message: x.messages[code.value],
+	}
+}
+
+// Wrap returns an Error of code with its message and cause.
+func (x Catalog[T, A]) Wrap(code Member[T, A], cause error) Error[T, A] {
+	err := x.New(code)
+	err.Cause = cause
+
+	return err
+}
+
+// Newf returns an Error of code with a message formatted by fmt.Sprintf in place of its own.
+func (x Catalog[T, A]) Newf(code Member[T, A], format string, a ...any) Error[T, A] {
+	return Error[T, A]{
+		Code:    code,
+		Message: fmt.Sprintf(format, a...),
+	}
+}
+
+// Error returns the code followed by the message and the cause, if present.
+func (x Error[_, _]) Error() string {
+	msg := string(x.Code.value)
+
+	if x.Message != "" {
+		msg += ": " + x.Message
+	}
+
+	if x.Cause != nil {
+		msg += ": " + x.Cause.Error()
+	}
+
+	return msg
+}
+
+// Is returns whether target is the code of x, so that errors.Is matches the bare Member.
+func (x Error[T, A]) Is(target error) bool {
+	code, ok := target.(Member[T, A])
+
+	return ok && x.Code.Is(code)
+}
+
+// Unwrap returns the cause of x.
+func (x Error[_, _]) Unwrap() error {
+	return x.Cause
+}
@@ -0,0 +1,104 @@
+package enum_test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/binaryphile/valor/enum"
+	"github.com/binaryphile/valor/result"
+)
+
+type code string
+
+const (
+	NotFound code = "not_found"
+	Conflict code = "conflict"
+)
+
+var (
+	Codes, _ = enum.Of[code, struct{}](NotFound, Conflict)
+
+	CodeNotFound = Codes.Member(string(NotFound)).MustOk()
+	CodeConflict = Codes.Member(string(Conflict)).MustOk()
+
+	Errors = enum.CatalogOf(Codes, map[code]string{
+		NotFound: "resource not found",
+		Conflict: "resource already exists",
+	})
+)
+
+func ExampleCatalog() {
+	for _, entry := range Errors.Entries() {
+		fmt.Printf("%s\t%s\n", entry.Code, entry.Message)
+	}
+	// Output:
+	// not_found	resource not found
+	// conflict	resource already exists
+}
+
+func TestCatalog_Wrap(t *testing.T) {
+	cause := errors.New("no rows")
+	err := fmt.Errorf("get widget: %w", Errors.Wrap(CodeNotFound, cause))
+
+	if got, want := err.Error(), "get widget: not_found: resource not found: no rows"; got != want {
+		t.Errorf("Error() = %v, want %v", got, want)
+	}
+	if !errors.Is(err, CodeNotFound) {
+		t.Errorf("errors.Is(err, CodeNotFound) = %v, want %v", false, true)
+	}
+	if errors.Is(err, CodeConflict) {
+		t.Errorf("errors.Is(err, CodeConflict) = %v, want %v", true, false)
+	}
+	if !errors.Is(err, cause) {
+		t.Errorf("errors.Is(err, cause) = %v, want %v", false, true)
+	}
+}
+
+func TestCatalog_Newf(t *testing.T) {
+	err := Errors.Newf(CodeConflict, "widget %d exists", 7)
+	if got, want := err.Error(), "conflict: widget 7 exists"; got != want {
+		t.Errorf("Error() = %v, want %v", got, want)
+	}
+	if err.Unwrap() != nil {
+		t.Errorf("Unwrap() = %v, want %v", err.Unwrap(), nil)
+	}
+}
+
+func TestError_result(t *testing.T) {
+	res := result.OfError[int](Errors.New(CodeConflict))
+
+	if !res.ErrorIs(CodeConflict) {
+		t.Errorf("ErrorIs() = %v, want %v", false, true)
+	}
+
+	var err enum.Error[code, struct{}]
+	if !res.ErrorAs(&err) {
+		t.Fatalf("ErrorAs() = %v, want %v", false, true)
+	}
+	if !err.Code.Is(CodeConflict) || err.Message != "resource already exists" {
+		t.Errorf("ErrorAs() target = %v, want %v", err, Errors.New(CodeConflict))
+	}
+}
+
+func TestMember_Is(t *testing.T) {
+	if !CodeNotFound.Is(CodeNotFound) {
+		t.Errorf("Is() = %v, want %v", false, true)
+	}
+	if CodeNotFound.Is(CodeConflict) {
+		t.Errorf("Is() = %v, want %v", true, false)
+	}
+
+	others, _ := enum.Of[code, struct{}](NotFound)
+	if CodeNotFound.Is(others.Member(string(NotFound)).MustOk()) {
+		t.Errorf("Is() of other enum = %v, want %v", true, false)
+	}
+	if zero := (enum.Member[code, struct{}]{}); zero.Is(zero) {
+		t.Errorf("Is() of zero Members = %v, want %v", true, false)
+	}
+
+	err := fmt.Errorf("lookup: %w", Errors.New(CodeNotFound))
+	if errors.Is(err, others.Member(string(NotFound)).MustOk()) {
+		t.Errorf("errors.Is() of other enum = %v, want %v", true, false)
+	}
+}
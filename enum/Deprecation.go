@@ -0,0 +1,146 @@
+package enum
+
+import (
+	"fmt"
+
+	"github.com/binaryphile/valor/optional"
+)
+
+type (
+	// DeprecationHook is called when Parse accepts the name of a deprecated member, such as to log or count its use.
+	// replacement is the member Parse returns instead, or the zero Member if there is none.
+	DeprecationHook[T ~string, A any] func(used, replacement Member[T, A])
+)
+
+// Deprecate marks member as deprecated in favor of replacement, such as a retired code and its successor.
+// Parse still accepts the names of member, but returns replacement in its place
+// after calling the hooks added by OnDeprecated.
+// replacement may be the zero Member, in which case Parse returns member.
+// Member is unaffected.
+// It is an error if a member is not of x, if replacement is deprecated or if member is the replacement of another.
+func (x Enum[T, A]) Deprecate(member, replacement Member[T, A]) error {
+	if !x.has(member) {
+		return fmt.Errorf("%q: %w", member.value, ErrNotMember)
+	}
+
+	next := optional.OfNotOk[int]()
+
+	if replacement != (Member[T, A]{}) {
+		if replacement.enum != x {
+			return fmt.Errorf("%q: %w", replacement.value, ErrNotMember)
+		}
+
+		next = optional.OfOk(replacement.ordinal)
+	}
+
+	return x.table.change(func() error {
+		if _, ok := x.table.deprecated[replacement.ordinal]; ok && next.IsOk() {
+			return fmt.Errorf("replacement %q of %q is deprecated", replacement.value, member.value)
+		}
+
+		for i, other := range x.table.deprecated {
+			if other == optional.OfOk(member.ordinal) {
+				return fmt.Errorf("%q is the replacement of %q", member.value, x.table.ordinal(i).value)
+			}
+		}
+
+		if next == optional.OfOk(member.ordinal) {
+			return fmt.Errorf("%q cannot replace itself", member.value)
+		}
+
+		if x.table.deprecated == nil {
+			x.table.deprecated = make(map[int]optional.Value[int])
+		}
+
+		x.table.deprecated[member.ordinal] = next
+
+		return nil
+	})
+}
+
+// OnDeprecated adds hook to those called, in the order added, when Parse accepts the name of a deprecated member.
+func (x Enum[T, A]) OnDeprecated(hook DeprecationHook[T, A]) {
+	if x.table == nil {
+		return
+	}
+
+	mustChange(x.table.change(func() error {
+		x.table.deprecationHooks = append(x.table.deprecationHooks, hook)
+
+		return nil
+	}))
+}
+
+// ActiveNames returns the names of the members that are not deprecated in declaration order.
+// This aids validating new input, which should not use deprecated names.
+func (x Enum[_, _]) ActiveNames() []string {
+	return namesOf(x.ActiveMembers())
+}
+
+// ActiveMembers returns the members that are not deprecated in declaration order.
+func (x Enum[T, A]) ActiveMembers() []Member[T, A] {
+	if x.table == nil {
+		return nil
+	}
+
+	x.table.mu.RLock()
+	defer x.table.mu.RUnlock()
+
+	members := make([]Member[T, A], 0, len(x.table.members))
+
+	for _, member := range x.table.members {
+		if _, ok := x.table.deprecated[member.ordinal]; !ok {
+			members = append(members, member)
+		}
+	}
+
+	return members
+}
+
+// Deprecated returns whether x is deprecated.
+func (x Member[_, _]) Deprecated() bool {
+	if x.enum.table == nil {
+		return false
+	}
+
+	x.enum.table.mu.RLock()
+	defer x.enum.table.mu.RUnlock()
+
+	_, ok := x.enum.table.deprecated[x.ordinal]
+
+	return ok
+}
+
+// Replacement returns the member that replaces x, if x is deprecated and has a replacement.
+func (x Member[T, A]) Replacement() optional.Value[Member[T, A]] {
+	if x.enum.table == nil {
+		return optional.OfNotOk[Member[T, A]]()
+	}
+
+	x.enum.table.mu.RLock()
+	defer x.enum.table.mu.RUnlock()
+
+	return optional.Map(x.enum.table.ordinal, x.enum.table.deprecated[x.ordinal])
+}
+
+// resolve returns the replacement of member if it is deprecated and has one, otherwise member.
+// If member is deprecated, the deprecation hooks are called first.
+func (x Enum[T, A]) resolve(member Member[T, A]) Member[T, A] {
+	// Copy the hooks so they are called unlocked, free to use the enum.
+	x.table.mu.RLock()
+	next, deprecated := x.table.deprecated[member.ordinal]
+	hooks := append([]DeprecationHook[T, A](nil), x.table.deprecationHooks...)
+	x.table.mu.RUnlock()
+
+	if !deprecated {
+		return member
+	}
+
+	replacement := optional.Map(x.ordinal, next)
+
+	for _, hook := range hooks {
+		hook(member, replacement.OrZero())
+	}
+
+	return replacement.Or(member)
+}
@@ -0,0 +1,142 @@
+package enum_test
+
+import (
+	"encoding/json"
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/binaryphile/valor/enum"
+)
+
+type region string
+
+func TestEnum_Deprecate(t *testing.T) {
+	regions, _ := enum.Of[region, struct{}]("amer", "eu", "emea", "legacy", "apac")
+	regions.Bind()
+	eu, emea := regions.Member("eu").MustOk(), regions.Member("emea").MustOk()
+	legacy := regions.Member("legacy").MustOk()
+
+	type use struct {
+		used, replacement enum.Member[region, struct{}]
+	}
+	var uses []use
+	regions.OnDeprecated(func(used, replacement enum.Member[region, struct{}]) {
+		uses = append(uses, use{used, replacement})
+	})
+
+	if err := regions.Deprecate(eu, emea); err != nil {
+		t.Fatalf("Deprecate() error = %v", err)
+	}
+	if err := regions.Deprecate(legacy, enum.Member[region, struct{}]{}); err != nil {
+		t.Fatalf("Deprecate() without replacement error = %v", err)
+	}
+
+	if got, err := regions.Parse("eu").Unpack(); err != nil || got != emea {
+		t.Errorf("Parse() = %v %v, want %v", got, err, emea)
+	}
+	if got, err := regions.Parse("legacy").Unpack(); err != nil || got != legacy {
+		t.Errorf("Parse() = %v %v, want %v", got, err, legacy)
+	}
+	if got := regions.Member("eu"); got.MustOk() != eu {
+		t.Errorf("Member() = %v, want %v", got, eu)
+	}
+	if got, want := uses, []use{{eu, emea}, {legacy, enum.Member[region, struct{}]{}}}; !reflect.DeepEqual(got, want) {
+		t.Errorf("hook calls = %v, want %v", got, want)
+	}
+
+	var decoded enum.Member[region, struct{}]
+	if err := json.Unmarshal([]byte(`"eu"`), &decoded); err != nil || decoded != emea {
+		t.Errorf("UnmarshalJSON() = %v %v, want %v", decoded, err, emea)
+	}
+
+	if got, want := regions.ActiveNames(), []string{"amer", "emea", "apac"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("ActiveNames() = %v, want %v", got, want)
+	}
+	if got, want := regions.Names(), []string{"amer", "eu", "emea", "legacy", "apac"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Names() = %v, want %v", got, want)
+	}
+	if _, err := regions.Parse("mars").Unpack(); err == nil || !strings.Contains(err.Error(), "(allowed: amer, emea, apac)") {
+		t.Errorf("Parse() error = %v, want active names allowed", err)
+	}
+
+	if !eu.Deprecated() || emea.Deprecated() {
+		t.Errorf("Deprecated() = %v %v, want %v %v", eu.Deprecated(), emea.Deprecated(), true, false)
+	}
+	if got := eu.Replacement(); got.MustOk() != emea {
+		t.Errorf("Replacement() = %v, want %v", got, emea)
+	}
+	if got := legacy.Replacement(); got.IsOk() {
+		t.Errorf("Replacement() = %v, want not ok", got)
+	}
+}
+
+func TestEnum_Deprecate_errors(t *testing.T) {
+	regions, _ := enum.Of[region, struct{}]("amer", "eu", "emea", "apac")
+	amer, eu := regions.Member("amer").MustOk(), regions.Member("eu").MustOk()
+	emea, apac := regions.Member("emea").MustOk(), regions.Member("apac").MustOk()
+	others, _ := enum.Of[region, struct{}]("eu")
+	regions.Bind()
+
+	if err := regions.Deprecate(eu, emea); err != nil {
+		t.Fatalf("Deprecate() error = %v", err)
+	}
+
+	tests := []struct {
+		name                string
+		member, replacement enum.Member[region, struct{}]
+		wantErr             error
+	}{
+		{name: "foreign member", member: others.Member("eu").MustOk(), replacement: emea, wantErr: enum.ErrNotMember},
+		{name: "foreign replacement", member: amer, replacement: others.Member("eu").MustOk(), wantErr: enum.ErrNotMember},
+		{name: "deprecated replacement", member: amer, replacement: eu},
+		{name: "replacement deprecated", member: emea, replacement: apac},
+		{name: "self", member: apac, replacement: apac},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := regions.Deprecate(tt.member, tt.replacement)
+			if err == nil || tt.wantErr != nil && !errors.Is(err, tt.wantErr) {
+				t.Errorf("Deprecate() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+
+	regions.Seal()
+	if err := regions.Deprecate(amer, apac); !errors.Is(err, enum.ErrSealed) {
+		t.Errorf("Deprecate() after Seal() error = %v, want %v", err, enum.ErrSealed)
+	}
+}
+
+func TestIntEnum_Deprecate(t *testing.T) {
+	opcodes, _ := newOpcodes()
+	notify, update := opcodes.Code(4).MustOk(), opcodes.Code(5).MustOk()
+
+	var used []string
+	opcodes.OnDeprecated(func(old, replacement enum.IntMember[opcode, string]) {
+		used = append(used, old.Name()+">"+replacement.Name())
+	})
+
+	if err := opcodes.Deprecate(notify, update); err != nil {
+		t.Fatalf("Deprecate() error = %v", err)
+	}
+
+	for _, s := range []string{"notify", "4"} {
+		if got, err := opcodes.Parse(s).Unpack(); err != nil || got != update {
+			t.Errorf("Parse(%q) = %v %v, want %v", s, got, err, update)
+		}
+	}
+	if got, want := used, []string{"notify>update", "notify>update"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("hook calls = %v, want %v", got, want)
+	}
+	if got, want := opcodes.ActiveNames(), []string{"query", "update"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("ActiveNames() = %v, want %v", got, want)
+	}
+	if !notify.Deprecated() || notify.Replacement().MustOk() != update {
+		t.Errorf("Deprecated(), Replacement() = %v %v, want %v %v", notify.Deprecated(), notify.Replacement(), true, update)
+	}
+	if _, err := opcodes.Parse("2").Unpack(); err == nil || !strings.Contains(err.Error(), "(allowed: query (1), update (5))") {
+		t.Errorf("Parse() error = %v, want active names allowed", err)
+	}
+}
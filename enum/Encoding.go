@@ -0,0 +1,117 @@
+package enum
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/binaryphile/valor/internal/binding"
+	"github.com/binaryphile/valor/result"
+)
+
+// Bind makes x the Enum used to decode zero Members of its type,
+// replacing any Enum bound before.
+// A zero Member cannot be decoded until an Enum of its type is bound,
+// since nothing else says which Enum to look its name up in.
+func (x Enum[T, A]) Bind() {
+	binding.Bind[Member[T, A]](x)
+}
+
+// MarshalJSON encodes x as its name.
+// The zero Member encodes as the literal null.
+func (x Member[_, _]) MarshalJSON() ([]byte, error) {
+	if x.enum.table == nil {
+		return []byte("null"), nil
+	}
+
+	return json.Marshal(string(x.value))
+}
+
+// UnmarshalJSON decodes a name into x.
+// It is an error if the name is not in the enum.
+// If x is a member, the name is looked up in its enum.
+// Otherwise it is looked up in the Enum bound to the type of x.
+// Does nothing if data is the literal null.
+func (x *Member[T, A]) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		// by convention, null is no-op
+		return nil
+	}
+
+	var name string
+	if err := json.Unmarshal(data, &name); err != nil {
+		return err
+	}
+
+	return x.parse(name)
+}
+
+// MarshalText encodes x as its name.
+// The zero Member encodes as empty text.
+func (x Member[_, _]) MarshalText() ([]byte, error) {
+	return []byte(x.value), nil
+}
+
+// UnmarshalText decodes a name into x.
+// The name is looked up as for UnmarshalJSON.
+// Does nothing if text is empty, which is how the zero Member encodes.
+func (x *Member[T, A]) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		// like null in JSON, empty text is no-op
+		return nil
+	}
+
+	return x.parse(string(text))
+}
+
+// EncodedValues returns the JSON values of the members of the enum of x in declaration order.
+// This aids describing the encoding, as in a JSON Schema.
+// The enum is found as for UnmarshalJSON.
+// Returns nil if there is no such enum.
+func (x Member[_, _]) EncodedValues() []any {
+	enum, err := x.decodingEnum().Unpack()
+	if err != nil {
+		return nil
+	}
+
+	names := enum.Names()
+
+	values := make([]any, len(names))
+	for i, name := range names {
+		values[i] = name
+	}
+
+	return values
+}
+
+// parse sets x to the member named name in the enum of x or the enum bound to its type.
+func (x *Member[T, A]) parse(name string) error {
+	member, err := result.FlatMap(func(enum Enum[T, A]) result.Result[Member[T, A]] {
+		return enum.Parse(name)
+	}, x.decodingEnum()).Unpack()
+	if err != nil {
+		return err
+	}
+
+	*x = member
+
+	return nil
+}
+
+// decodingEnum returns the enum of x if x is a member, otherwise the Enum bound to its type.
+func (x Member[T, A]) decodingEnum() result.Result[Enum[T, A]] {
+	if x.enum.table != nil {
+		return result.OfOk(x.enum)
+	}
+
+	return boundEnum[T, A]()
+}
+
+// boundEnum returns the Enum bound to Member[T, A].
+func boundEnum[T ~string, A any]() result.Result[Enum[T, A]] {
+	enum, ok := binding.Bound[Member[T, A], Enum[T, A]]().Unpack()
+	if !ok {
+		return result.OfError[Enum[T, A]](fmt.Errorf("no Enum bound to %v; call Bind on one", binding.TypeOf[Member[T, A]]()))
+	}
+
+	return result.OfOk(enum)
+}
@@ -0,0 +1,126 @@
+package enum_test
+
+import (
+	"encoding/json"
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/binaryphile/valor/enum"
+)
+
+// type checks
+var (
+	_ json.Marshaler   = enum.Member[status, statusInfo]{}
+	_ json.Unmarshaler = &enum.Member[status, statusInfo]{}
+)
+
+type account struct {
+	Status enum.Member[status, statusInfo] `json:"status"`
+}
+
+func TestMember_MarshalJSON(t *testing.T) {
+	b, err := json.Marshal(account{Status: Statuses.Member(string(Retired)).MustOk()})
+	if err != nil {
+		t.Fatalf("MarshalJSON() error = %v", err)
+	}
+	if got, want := string(b), `{"status":"retired"}`; got != want {
+		t.Errorf("MarshalJSON() = %v, want %v", got, want)
+	}
+
+	b, err = json.Marshal(account{})
+	if err != nil {
+		t.Fatalf("MarshalJSON() error = %v", err)
+	}
+	if got, want := string(b), `{"status":null}`; got != want {
+		t.Errorf("MarshalJSON() = %v, want %v", got, want)
+	}
+}
+
+func TestMember_UnmarshalJSON(t *testing.T) {
+	var got account
+	if err := json.Unmarshal([]byte(`{"status":"retired"}`), &got); err != nil {
+		t.Fatalf("UnmarshalJSON() error = %v", err)
+	}
+	if want := Statuses.Member(string(Retired)).MustOk(); got.Status != want {
+		t.Errorf("UnmarshalJSON() = %v, want %v", got.Status, want)
+	}
+	if got.Status.Attr().Code != 410 {
+		t.Errorf("Attr() after UnmarshalJSON() = %v, want %v", got.Status.Attr().Code, 410)
+	}
+
+	if err := json.Unmarshal([]byte(`{"status":null}`), &got); err != nil {
+		t.Fatalf("UnmarshalJSON() error = %v", err)
+	}
+	if want := Statuses.Member(string(Retired)).MustOk(); got.Status != want {
+		t.Errorf("UnmarshalJSON() of null = %v, want %v", got.Status, want)
+	}
+
+	err := json.Unmarshal([]byte(`{"status":"pending"}`), &got)
+	if !errors.Is(err, enum.ErrNotMember) {
+		t.Errorf("UnmarshalJSON() error = %v, want %v", err, enum.ErrNotMember)
+	}
+	if want := `"pending": not a member of the enum (allowed: active, retired)`; err == nil || err.Error() != want {
+		t.Errorf("UnmarshalJSON() error = %v, want %v", err, want)
+	}
+}
+
+func TestMember_UnmarshalJSON_bind(t *testing.T) {
+	type color string
+	first, _ := enum.Of[color, struct{}]("red", "green")
+	second, _ := enum.Of[color, struct{}]("cyan", "magenta")
+
+	var got enum.Member[color, struct{}]
+	if err := json.Unmarshal([]byte(`"red"`), &got); err == nil {
+		t.Errorf("UnmarshalJSON() without Bind() error = %v, want error", err)
+	}
+
+	// a member knows its enum
+	got = second.Member("cyan").MustOk()
+	if err := json.Unmarshal([]byte(`"magenta"`), &got); err != nil {
+		t.Fatalf("UnmarshalJSON() error = %v", err)
+	}
+	if want := second.Member("magenta").MustOk(); got != want {
+		t.Errorf("UnmarshalJSON() = %v, want %v", got, want)
+	}
+
+	first.Bind()
+	got = enum.Member[color, struct{}]{}
+	if err := json.Unmarshal([]byte(`"green"`), &got); err != nil {
+		t.Fatalf("UnmarshalJSON() after Bind() error = %v", err)
+	}
+	if want := first.Member("green").MustOk(); got != want {
+		t.Errorf("UnmarshalJSON() after Bind() = %v, want %v", got, want)
+	}
+
+	// creating another Enum leaves the binding alone
+	enum.Of[color, struct{}]("red")
+	got = enum.Member[color, struct{}]{}
+	if err := json.Unmarshal([]byte(`"red"`), &got); err != nil || got != first.Member("red").MustOk() {
+		t.Errorf("UnmarshalJSON() after Of() = %v %v, want %v", got, err, first.Member("red").MustOk())
+	}
+}
+
+func TestMember_EncodedValues(t *testing.T) {
+	want := []any{"active", "retired"}
+	if got := Statuses.Member(string(Active)).MustOk().EncodedValues(); !reflect.DeepEqual(got, want) {
+		t.Errorf("EncodedValues() = %v, want %v", got, want)
+	}
+	if got := (enum.Member[status, statusInfo]{}).EncodedValues(); !reflect.DeepEqual(got, want) {
+		t.Errorf("EncodedValues() of zero Member = %v, want %v", got, want)
+	}
+
+	type shade string
+	if got := (enum.Member[shade, struct{}]{}).EncodedValues(); got != nil {
+		t.Errorf("EncodedValues() without Enum = %v, want %v", got, nil)
+	}
+}
+
+func TestEnum_Parse(t *testing.T) {
+	if got := Statuses.Parse("active"); got.Value().MustOk() != Statuses.Member("active").MustOk() {
+		t.Errorf("Parse() = %v, want %v", got, Statuses.Member("active").MustOk())
+	}
+	if got := Statuses.Parse("Active"); !got.ErrorIs(enum.ErrNotMember) {
+		t.Errorf("Parse() = %v, want %v", got, enum.ErrNotMember)
+	}
+}
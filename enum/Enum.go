@@ -1,68 +1,270 @@
 package enum
 
 import (
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/bits-and-blooms/bitset"
+
 	"github.com/binaryphile/valor/optional"
+	"github.com/binaryphile/valor/result"
+)
+
+var (
+	// ErrNotMember is the error for a name that is not in an Enum.
+	ErrNotMember = errors.New("not a member of the enum")
+
+	// ErrSealed is the error for changing an Enum after it has been sealed.
+	ErrSealed = errors.New("enum is sealed")
 )
 
 type (
 	Enum[T ~string, A any] struct {
-		members map[string]Member[T, A]
+		table *table[T, A]
+	}
+
+	// Def defines a member of an Enum along with its attribute.
+	Def[T ~string, A any] struct {
+		Value T
+		Attr  A
+	}
+
+	// alias is another name for the member with ordinal.
+	alias struct {
+		name    string
+		ordinal int
+	}
+
+	// table is the state shared by an Enum and its members.
+	// Sharing it by pointer keeps Enum and Member comparable.
+	// members and attrs are in declaration order, indexed by ordinal.
+	// mu guards the other fields, which may change until sealed is set.
+	table[T ~string, A any] struct {
+		mu        sync.RWMutex
+		sealed    bool
+		members   []Member[T, A]
+		attrs     []A
+		index     map[string]int
+		aliases   []alias
+		normalize Normalizer
+		// transitions has the ordinals each member may transition to, by ordinal.
+		transitions map[int]*bitset.BitSet
+		exitHooks   map[int][]Hook[T, A]
+		enterHooks  map[int][]Hook[T, A]
+		// deprecated has the ordinal of the replacement of each deprecated member, if any, by ordinal.
+		deprecated       map[int]optional.Value[int]
+		deprecationHooks []DeprecationHook[T, A]
 	}
 )
 
 // Of creates an Enum of the given string values.
 // It also returns a function for generating members.
 // The function is intended for use by the enum creator and
-// should be discarded or not exported in order to seal the enum.
+// should be discarded or not exported.
+// It is safe for concurrent use, but panics once the enum is sealed; see Seal.
+// Members have the zero attribute; use OfDefs to give them attributes.
 func Of[T ~string, A any](items ...T) (Enum[T, A], func(T) Member[T, A]) {
-	members := make(map[string]Member[T, A])
+	defs := make([]Def[T, A], len(items))
 
-	enum := Enum[T, A]{
-		members: members,
+	for i, item := range items {
+		defs[i].Value = item
 	}
 
-	for i, item := range items {
-		name := string(item)
+	enum, generate := OfDefs(defs...)
 
-		members[name] = Member[T, A]{
-			enum:  enum,
-			place: i,
-			value: item,
-		}
+	return enum, func(item T) Member[T, A] {
+		var attr A
+
+		return generate(item, attr)
 	}
+}
 
-	return enum, func(item T) Member[T, A] {
-		name := string(item)
+// OfDefs creates an Enum of the given values, each with an attribute
+// that is available from the member's Attr method.
+// This keeps metadata such as a description or status code
+// with the member's declaration rather than in a parallel map.
+// It also returns a function for generating members, as Of does.
+// A value repeated in defs or given again to the function is not added twice;
+// the member keeps its first ordinal and attribute.
+func OfDefs[T ~string, A any](defs ...Def[T, A]) (Enum[T, A], func(T, A) Member[T, A]) {
+	enum := Enum[T, A]{
+		table: &table[T, A]{
+			index:     make(map[string]int),
+			normalize: Exact,
+		},
+	}
 
-		member := Member[T, A]{
-			enum:  enum,
-			place: len(members),
-			value: item,
-		}
+	for _, def := range defs {
+		enum.table.add(enum, def)
+	}
+
+	return enum, func(item T, attr A) Member[T, A] {
+		var member Member[T, A]
+
+		if err := enum.table.change(func() error {
+			member = enum.table.add(enum, DefOf(item, attr))
 
-		members[name] = member
+			return nil
+		}); err != nil {
+			panic(fmt.Errorf("%q: %w", item, err))
+		}
 
 		return member
 	}
 }
 
-func (x Enum[_, _]) Includes(name string) bool {
-	_, ok := x.members[name]
+// DefOf creates a Def of value and attr.
+func DefOf[T ~string, A any](value T, attr A) Def[T, A] {
+	return Def[T, A]{
+		Value: value,
+		Attr:  attr,
+	}
+}
 
-	return ok
+func (x Enum[_, _]) Includes(name string) bool {
+	return x.Member(name).IsOk()
 }
 
+// Names returns the names of the members in declaration order.
 func (x Enum[_, _]) Names() []string {
-	names := make([]string, len(x.members))
+	return namesOf(x.Members())
+}
 
-	// TODO: make length safe in case of repeats messing with it
-	for _, member := range x.members {
-		names[member.place] = string(member.value)
+// Members returns the members in declaration order.
+func (x Enum[T, A]) Members() []Member[T, A] {
+	if x.table == nil {
+		return nil
 	}
 
-	return names
+	x.table.mu.RLock()
+	defer x.table.mu.RUnlock()
+
+	return append([]Member[T, A](nil), x.table.members...)
 }
 
 func (x Enum[T, A]) Member(name string) optional.Value[Member[T, A]] {
-	return optional.OfIndex(x.members, name)
+	if x.table == nil {
+		return optional.OfNotOk[Member[T, A]]()
+	}
+
+	x.table.mu.RLock()
+	defer x.table.mu.RUnlock()
+
+	return optional.Map(x.table.ordinal, optional.OfIndex(x.table.index, name))
+}
+
+// Seal prevents further changes to x.
+// Afterward, generating a member panics, as does any method that changes x
+// without returning an error; the others return an error wrapping ErrSealed.
+// Sealing an Enum once it is declared guarantees it is the same everywhere it is used.
+func (x Enum[_, _]) Seal() {
+	if x.table == nil {
+		return
+	}
+
+	x.table.mu.Lock()
+	defer x.table.mu.Unlock()
+
+	x.table.sealed = true
+}
+
+// Sealed returns whether x is sealed.
+func (x Enum[_, _]) Sealed() bool {
+	if x.table == nil {
+		return false
+	}
+
+	x.table.mu.RLock()
+	defer x.table.mu.RUnlock()
+
+	return x.table.sealed
+}
+
+// ordinal returns the member with ordinal i, which must be in range.
+func (x Enum[T, A]) ordinal(i int) Member[T, A] {
+	x.table.mu.RLock()
+	defer x.table.mu.RUnlock()
+
+	return x.table.ordinal(i)
+}
+
+// Parse returns a Result of the member named name.
+// Unlike Member, Parse also accepts a name that matches a member's name or alias
+// once both are normalized; see SetNormalizer and Alias.
+// The name of a deprecated member returns its replacement, if any; see Deprecate.
+// If there is no such member, the error wraps ErrNotMember and lists the active names.
+func (x Enum[T, A]) Parse(name string) result.Result[Member[T, A]] {
+	if member := x.lookup(name); member.IsOk() {
+		return result.OfOk(x.resolve(member.MustOk()))
+	}
+
+	return result.OfError[Member[T, A]](fmt.Errorf("%q: %w (allowed: %s)", name, ErrNotMember, strings.Join(x.ActiveNames(), ", ")))
+}
+
+// has returns whether member is of x.
+// The zero Enum has no members, not even the zero Member.
+func (x Enum[T, A]) has(member Member[T, A]) bool {
+	return x.table != nil && member.enum == x
+}
+
+// mustHave panics with an error wrapping ErrNotMember if member is not of x.
+func (x Enum[T, A]) mustHave(member Member[T, A]) {
+	if !x.has(member) {
+		panic(fmt.Errorf("%q: %w", member.value, ErrNotMember))
+	}
+}
+
+// change calls f with x locked for writing.
+// Returns ErrSealed without calling f if x is sealed.
+func (x *table[T, A]) change(f func() error) error {
+	x.mu.Lock()
+	defer x.mu.Unlock()
+
+	if x.sealed {
+		return ErrSealed
+	}
+
+	return f()
+}
+
+// ordinal returns the member with ordinal i, which must be in range.
+// x must be locked.
+func (x *table[T, A]) ordinal(i int) Member[T, A] {
+	return x.members[i]
+}
+
+// add adds def to x as a member of enum, unless a member of the same name exists.
+// Returns the member of that name.
+// x must be locked for writing.
+func (x *table[T, A]) add(enum Enum[T, A], def Def[T, A]) Member[T, A] {
+	name := string(def.Value)
+
+	if i, ok := x.index[name]; ok {
+		return x.members[i]
+	}
+
+	member := Member[T, A]{
+		enum:    enum,
+		ordinal: len(x.members),
+		value:   def.Value,
+	}
+
+	x.index[name] = member.ordinal
+	x.members = append(x.members, member)
+	x.attrs = append(x.attrs, def.Attr)
+
+	return member
+}
+
+// namesOf returns the names of members.
+func namesOf[T ~string, A any](members []Member[T, A]) []string {
+	names := make([]string, len(members))
+
+	for i, member := range members {
+		names[i] = string(member.value)
+	}
+
+	return names
 }
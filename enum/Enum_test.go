@@ -0,0 +1,145 @@
+package enum_test
+
+import (
+	"fmt"
+	"net/http"
+	"reflect"
+	"sort"
+	"testing"
+
+	"github.com/binaryphile/valor/enum"
+	"github.com/binaryphile/valor/optional"
+)
+
+type (
+	status string
+
+	statusInfo struct {
+		Code  int
+		Label string
+	}
+)
+
+var Statuses, _ = enum.OfDefs(
+	enum.DefOf[status](Active, statusInfo{http.StatusOK, "Active"}),
+	enum.DefOf[status](Retired, statusInfo{http.StatusGone, "Retired"}),
+)
+
+const (
+	Active  status = "active"
+	Retired status = "retired"
+)
+
+func init() {
+	// zero Members decode in the bound Enum
+	Statuses.Bind()
+	Permissions.Bind()
+}
+
+func ExampleOfDefs() {
+	retired := Statuses.Member(string(Retired)).MustOk()
+	fmt.Println(retired.Attr().Label, retired.Attr().Code)
+	// Output: Retired 410
+}
+
+func TestMember_Attr(t *testing.T) {
+	letters, generate := enum.OfDefs(enum.DefOf("a", 1))
+	if got := letters.Member("a").MustOk().Attr(); got != 1 {
+		t.Errorf("Attr() = %v, want %v", got, 1)
+	}
+	if got := generate("b", 2).Attr(); got != 2 {
+		t.Errorf("Attr() = %v, want %v", got, 2)
+	}
+	if got := CodeNotFound.Attr(); got != struct{}{} {
+		t.Errorf("Attr() = %v, want %v", got, struct{}{})
+	}
+	var zero enum.Member[string, int]
+	if got := zero.Attr(); got != 0 {
+		t.Errorf("Attr() = %v, want %v", got, 0)
+	}
+}
+
+func TestMember_comparable(t *testing.T) {
+	a := Statuses.Member(string(Active)).MustOk()
+	if a != Statuses.Member(string(Active)).MustOk() {
+		t.Errorf("Member == Member = %v, want %v", false, true)
+	}
+	if a == Statuses.Member(string(Retired)).MustOk() {
+		t.Errorf("Member == other Member = %v, want %v", true, false)
+	}
+}
+
+func TestOf_duplicates(t *testing.T) {
+	letters, generate := enum.Of[string, struct{}]("a", "b", "a", "c")
+	if got, want := letters.Names(), []string{"a", "b", "c"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Names() = %v, want %v", got, want)
+	}
+
+	d := generate("d")
+	if got := d.Ordinal(); got != 3 {
+		t.Errorf("Ordinal() = %v, want %v", got, 3)
+	}
+	if got := generate("b"); got != letters.Member("b").MustOk() {
+		t.Errorf("generate() of existing = %v, want %v", got, letters.Member("b").MustOk())
+	}
+	if got := generate("e").Ordinal(); got != 4 {
+		t.Errorf("Ordinal() after duplicate = %v, want %v", got, 4)
+	}
+	if got, want := letters.Names(), []string{"a", "b", "c", "d", "e"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Names() = %v, want %v", got, want)
+	}
+}
+
+func TestEnum_Members(t *testing.T) {
+	letters, _ := enum.Of[string, struct{}]("c", "a", "b")
+	members := letters.Members()
+	for i, member := range members {
+		if member.Ordinal() != i {
+			t.Errorf("Ordinal() = %v, want %v", member.Ordinal(), i)
+		}
+	}
+	members[0] = members[1]
+	if got := letters.Members()[0].Name(); got != "c" {
+		t.Errorf("Members()[0] after modifying copy = %v, want %v", got, "c")
+	}
+	if got := (enum.Enum[string, struct{}]{}).Members(); got != nil {
+		t.Errorf("Members() of zero Enum = %v, want %v", got, nil)
+	}
+}
+
+func TestMember_NextPrev(t *testing.T) {
+	letters, _ := enum.Of[string, struct{}]("a", "b", "c")
+	a, b, c := letters.Member("a").MustOk(), letters.Member("b").MustOk(), letters.Member("c").MustOk()
+
+	if got := a.Next(); got != optional.OfOk(b) {
+		t.Errorf("Next() = %v, want %v", got, optional.OfOk(b))
+	}
+	if got := c.Next(); got.IsOk() {
+		t.Errorf("Next() of last = %v, want not ok", got)
+	}
+	if got := c.Prev(); got != optional.OfOk(b) {
+		t.Errorf("Prev() = %v, want %v", got, optional.OfOk(b))
+	}
+	if got := a.Prev(); got.IsOk() {
+		t.Errorf("Prev() of first = %v, want not ok", got)
+	}
+	if got := (enum.Member[string, struct{}]{}).Next(); got.IsOk() {
+		t.Errorf("Next() of zero Member = %v, want not ok", got)
+	}
+}
+
+func TestMember_Compare(t *testing.T) {
+	letters, _ := enum.Of[string, struct{}]("c", "a", "b")
+	members := []enum.Member[string, struct{}]{
+		letters.Member("b").MustOk(),
+		letters.Member("c").MustOk(),
+		letters.Member("a").MustOk(),
+	}
+	sort.Slice(members, func(i, j int) bool { return members[i].Compare(members[j]) < 0 })
+	if got, want := members, letters.Members(); !reflect.DeepEqual(got, want) {
+		t.Errorf("sorted = %v, want %v", got, want)
+	}
+	if got := members[0].Compare(members[0]); got != 0 {
+		t.Errorf("Compare() = %v, want %v", got, 0)
+	}
+}
@@ -0,0 +1,159 @@
+package enum
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/bits-and-blooms/bitset"
+
+	"github.com/binaryphile/valor/result"
+)
+
+// ErrTransition is the error for a transition between members that is not allowed.
+var ErrTransition = errors.New("transition not allowed")
+
+type (
+	// Hook is called when a transition exits or enters a member.
+	// An error from a hook fails the transition.
+	Hook[T ~string, A any] func(from, to Member[T, A]) error
+)
+
+// Allow allows transitions from one member to each of the to members,
+// making the members of x the states of a finite state machine.
+// It is an error if a member is not of x.
+func (x Enum[T, A]) Allow(from Member[T, A], to ...Member[T, A]) error {
+	for _, member := range append([]Member[T, A]{from}, to...) {
+		if !x.has(member) {
+			return fmt.Errorf("%q: %w", member.value, ErrNotMember)
+		}
+	}
+
+	return x.table.change(func() error {
+		if x.table.transitions == nil {
+			x.table.transitions = make(map[int]*bitset.BitSet)
+		}
+
+		targets, ok := x.table.transitions[from.ordinal]
+		if !ok {
+			targets = bitset.New(uint(len(x.table.members)))
+			x.table.transitions[from.ordinal] = targets
+		}
+
+		for _, member := range to {
+			targets.Set(uint(member.ordinal))
+		}
+
+		return nil
+	})
+}
+
+// OnExit adds hook to those called, in the order added, when a transition leaves member.
+// It panics with an error wrapping ErrNotMember if member is not of x.
+func (x Enum[T, A]) OnExit(member Member[T, A], hook Hook[T, A]) {
+	x.mustHave(member)
+
+	mustChange(x.table.change(func() error {
+		if x.table.exitHooks == nil {
+			x.table.exitHooks = make(map[int][]Hook[T, A])
+		}
+
+		x.table.exitHooks[member.ordinal] = append(x.table.exitHooks[member.ordinal], hook)
+
+		return nil
+	}))
+}
+
+// OnEnter adds hook to those called, in the order added, when a transition arrives at member.
+// Enter hooks are called after exit hooks.
+// It panics with an error wrapping ErrNotMember if member is not of x.
+func (x Enum[T, A]) OnEnter(member Member[T, A], hook Hook[T, A]) {
+	x.mustHave(member)
+
+	mustChange(x.table.change(func() error {
+		if x.table.enterHooks == nil {
+			x.table.enterHooks = make(map[int][]Hook[T, A])
+		}
+
+		x.table.enterHooks[member.ordinal] = append(x.table.enterHooks[member.ordinal], hook)
+
+		return nil
+	}))
+}
+
+// CanTransitionTo returns whether a transition from x to to is allowed.
+func (x Member[T, A]) CanTransitionTo(to Member[T, A]) bool {
+	if x.enum.table == nil || to.enum != x.enum {
+		return false
+	}
+
+	x.enum.table.mu.RLock()
+	defer x.enum.table.mu.RUnlock()
+
+	targets, ok := x.enum.table.transitions[x.ordinal]
+
+	return ok && targets.Test(uint(to.ordinal))
+}
+
+// Transitions returns the members x may transition to, in declaration order.
+func (x Member[T, A]) Transitions() []Member[T, A] {
+	if x.enum.table == nil {
+		return nil
+	}
+
+	x.enum.table.mu.RLock()
+	targets := Set[T, A]{
+		enum: x.enum,
+		bits: x.enum.table.transitions[x.ordinal],
+	}.clone()
+	x.enum.table.mu.RUnlock()
+
+	return targets.Members()
+}
+
+// TransitionTo returns a Result of to if a transition from x to to is allowed,
+// after calling the exit hooks of x and then the enter hooks of to.
+// If the transition is not allowed, the error wraps ErrTransition.
+// If a hook fails, the Result contains its error and the remaining hooks are not called.
+// Either way, the caller should remain in x.
+func (x Member[T, A]) TransitionTo(to Member[T, A]) result.Result[Member[T, A]] {
+	if !x.CanTransitionTo(to) {
+		return result.OfError[Member[T, A]](fmt.Errorf("%q -> %q: %w (allowed: %s)",
+			x.value, to.value, ErrTransition, strings.Join(namesOf(x.Transitions()), ", ")))
+	}
+
+	// Copy the hooks so they are called unlocked, free to use the enum.
+	x.enum.table.mu.RLock()
+	hooks := append(append([]Hook[T, A](nil), x.enum.table.exitHooks[x.ordinal]...), x.enum.table.enterHooks[to.ordinal]...)
+	x.enum.table.mu.RUnlock()
+
+	for _, hook := range hooks {
+		if err := hook(x, to); err != nil {
+			return result.OfError[Member[T, A]](fmt.Errorf("%q -> %q: %w", x.value, to.value, err))
+		}
+	}
+
+	return result.OfOk(to)
+}
+
+// DOT returns the allowed transitions as a Graphviz digraph.
+// Each member is a node and each allowed transition an edge.
+func (x Enum[_, _]) DOT() string {
+	var b strings.Builder
+
+	b.WriteString("digraph {\n")
+
+	for _, member := range x.Members() {
+		fmt.Fprintf(&b, "\t%q;\n", member.value)
+	}
+
+	for _, member := range x.Members() {
+		for _, to := range member.Transitions() {
+			fmt.Fprintf(&b, "\t%q -> %q;\n", member.value, to.value)
+		}
+	}
+
+	b.WriteString("}\n")
+
+	return b.String()
+}
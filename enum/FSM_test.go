@@ -0,0 +1,186 @@
+package enum_test
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/binaryphile/valor/enum"
+)
+
+type orderStatus string
+
+type orderMachine struct {
+	statuses                                    enum.Enum[orderStatus, struct{}]
+	pending, paid, shipped, canceled, delivered enum.Member[orderStatus, struct{}]
+}
+
+func newOrderMachine(t *testing.T) orderMachine {
+	statuses, _ := enum.Of[orderStatus, struct{}]("pending", "paid", "shipped", "delivered", "canceled")
+	m := orderMachine{
+		statuses:  statuses,
+		pending:   statuses.Member("pending").MustOk(),
+		paid:      statuses.Member("paid").MustOk(),
+		shipped:   statuses.Member("shipped").MustOk(),
+		delivered: statuses.Member("delivered").MustOk(),
+		canceled:  statuses.Member("canceled").MustOk(),
+	}
+	for _, err := range []error{
+		statuses.Allow(m.pending, m.paid, m.canceled),
+		statuses.Allow(m.paid, m.shipped, m.canceled),
+		statuses.Allow(m.shipped, m.delivered),
+	} {
+		if err != nil {
+			t.Fatalf("Allow() error = %v", err)
+		}
+	}
+	return m
+}
+
+func TestMember_CanTransitionTo(t *testing.T) {
+	m := newOrderMachine(t)
+
+	if !m.pending.CanTransitionTo(m.paid) {
+		t.Errorf("CanTransitionTo() = %v, want %v", false, true)
+	}
+	if m.paid.CanTransitionTo(m.pending) || m.delivered.CanTransitionTo(m.canceled) {
+		t.Errorf("CanTransitionTo() = %v, want %v", true, false)
+	}
+	if got, want := m.paid.Transitions(), []enum.Member[orderStatus, struct{}]{m.shipped, m.canceled}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Transitions() = %v, want %v", got, want)
+	}
+}
+
+func TestMember_TransitionTo(t *testing.T) {
+	m := newOrderMachine(t)
+	var calls []string
+	hook := func(name string) enum.Hook[orderStatus, struct{}] {
+		return func(from, to enum.Member[orderStatus, struct{}]) error {
+			calls = append(calls, fmt.Sprintf("%s %s->%s", name, from, to))
+			return nil
+		}
+	}
+	m.statuses.OnExit(m.pending, hook("exit"))
+	m.statuses.OnEnter(m.paid, hook("enter"))
+	m.statuses.OnEnter(m.canceled, hook("enter"))
+
+	got := m.pending.TransitionTo(m.paid)
+	if got.IsError() || got.Value().MustOk() != m.paid {
+		t.Errorf("TransitionTo() = %v, want %v", got, m.paid)
+	}
+	if want := []string{"exit pending->paid", "enter pending->paid"}; !reflect.DeepEqual(calls, want) {
+		t.Errorf("hooks called = %v, want %v", calls, want)
+	}
+
+	calls = nil
+	got = m.shipped.TransitionTo(m.canceled)
+	if !got.ErrorIs(enum.ErrTransition) {
+		t.Errorf("TransitionTo() = %v, want %v", got, enum.ErrTransition)
+	}
+	if want := `"shipped" -> "canceled": transition not allowed (allowed: delivered)`; got.Error().Error() != want {
+		t.Errorf("TransitionTo() error = %v, want %v", got.Error(), want)
+	}
+	if calls != nil {
+		t.Errorf("hooks called = %v, want none", calls)
+	}
+}
+
+func TestMember_TransitionTo_hookError(t *testing.T) {
+	m := newOrderMachine(t)
+	errUnpaid := errors.New("payment declined")
+	entered := false
+	m.statuses.OnExit(m.pending, func(from, to enum.Member[orderStatus, struct{}]) error {
+		if to == m.paid {
+			return errUnpaid
+		}
+		return nil
+	})
+	m.statuses.OnEnter(m.paid, func(_, _ enum.Member[orderStatus, struct{}]) error {
+		entered = true
+		return nil
+	})
+
+	if got := m.pending.TransitionTo(m.paid); !got.ErrorIs(errUnpaid) {
+		t.Errorf("TransitionTo() = %v, want %v", got, errUnpaid)
+	}
+	if entered {
+		t.Errorf("enter hook called after exit hook failed")
+	}
+	if got := m.pending.TransitionTo(m.canceled); got.IsError() {
+		t.Errorf("TransitionTo() = %v, want %v", got, m.canceled)
+	}
+}
+
+func TestEnum_Allow_otherEnum(t *testing.T) {
+	m := newOrderMachine(t)
+	other := newOrderMachine(t)
+
+	if err := m.statuses.Allow(m.pending, other.shipped); !errors.Is(err, enum.ErrNotMember) {
+		t.Errorf("Allow() error = %v, want %v", err, enum.ErrNotMember)
+	}
+	if m.pending.CanTransitionTo(other.paid) {
+		t.Errorf("CanTransitionTo() of other enum = %v, want %v", true, false)
+	}
+}
+
+func TestEnum_OnExit_otherEnum(t *testing.T) {
+	m := newOrderMachine(t)
+	other := newOrderMachine(t)
+	hook := func(from, to enum.Member[orderStatus, struct{}]) error { return nil }
+
+	for name, add := range map[string]func(){
+		"OnExit":  func() { m.statuses.OnExit(other.pending, hook) },
+		"OnEnter": func() { m.statuses.OnEnter(other.paid, hook) },
+	} {
+		func() {
+			defer func() {
+				if err, _ := recover().(error); !errors.Is(err, enum.ErrNotMember) {
+					t.Errorf("%v() of other enum panic = %v, want %v", name, err, enum.ErrNotMember)
+				}
+			}()
+			add()
+		}()
+	}
+}
+
+func TestEnum_zero(t *testing.T) {
+	var zero enum.Enum[orderStatus, struct{}]
+	var member enum.Member[orderStatus, struct{}]
+
+	if err := zero.Allow(member, member); !errors.Is(err, enum.ErrNotMember) {
+		t.Errorf("Allow() error = %v, want %v", err, enum.ErrNotMember)
+	}
+	zero.SetNormalizer(enum.Exact)
+	zero.Seal()
+	if zero.Sealed() {
+		t.Errorf("Sealed() = %v, want %v", true, false)
+	}
+
+	defer func() {
+		if err, _ := recover().(error); !errors.Is(err, enum.ErrNotMember) {
+			t.Errorf("OnExit() panic = %v, want %v", err, enum.ErrNotMember)
+		}
+	}()
+	zero.OnExit(member, func(from, to enum.Member[orderStatus, struct{}]) error { return nil })
+}
+
+func TestEnum_DOT(t *testing.T) {
+	m := newOrderMachine(t)
+	want := `digraph {
+	"pending";
+	"paid";
+	"shipped";
+	"delivered";
+	"canceled";
+	"pending" -> "paid";
+	"pending" -> "canceled";
+	"paid" -> "shipped";
+	"paid" -> "canceled";
+	"shipped" -> "delivered";
+}
+`
+	if got := m.statuses.DOT(); got != want {
+		t.Errorf("DOT() = %v, want %v", got, want)
+	}
+}
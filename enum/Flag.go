@@ -0,0 +1,52 @@
+package enum
+
+import (
+	"flag"
+	"fmt"
+	"strings"
+)
+
+type (
+	// flagValue is a flag.Value that sets a member of an enum.
+	flagValue[T ~string, A any] struct {
+		enum   Enum[T, A]
+		member *Member[T, A]
+	}
+)
+
+// FlagVar defines a flag in fs with name and usage that sets p to a member of enum.
+// The value of p when FlagVar is called is the default; the zero Member means no default.
+// The allowed names are appended to usage, and setting the flag to any other name is an error.
+func FlagVar[T ~string, A any](fs *flag.FlagSet, p *Member[T, A], name string, enum Enum[T, A], usage string) {
+	fs.Var(&flagValue[T, A]{
+		enum:   enum,
+		member: p,
+	}, name, fmt.Sprintf("%s (one of: %s)", usage, strings.Join(enum.Names(), ", ")))
+}
+
+// Get returns the member.
+func (x *flagValue[_, _]) Get() any {
+	return *x.member
+}
+
+// Set sets the member to the one named name.
+func (x *flagValue[_, _]) Set(name string) error {
+	member, err := x.enum.Parse(name).Unpack()
+	if err != nil {
+		return err
+	}
+
+	*x.member = member
+
+	return nil
+}
+
+// String returns the name of the member.
+func (x *flagValue[_, _]) String() string {
+	// The flag package calls String on a zero flagValue to detect defaults.
+	if x == nil || x.member == nil {
+		return ""
+	}
+
+	return x.member.String()
+}
@@ -0,0 +1,97 @@
+package enum_test
+
+import (
+	"encoding"
+	"errors"
+	"flag"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/binaryphile/valor/enum"
+)
+
+// type checks
+var (
+	_ encoding.TextMarshaler   = enum.Member[status, statusInfo]{}
+	_ encoding.TextUnmarshaler = &enum.Member[status, statusInfo]{}
+)
+
+func TestFlagVar(t *testing.T) {
+	newFlagSet := func(def enum.Member[status, statusInfo]) (*flag.FlagSet, *enum.Member[status, statusInfo]) {
+		fs := flag.NewFlagSet("test", flag.ContinueOnError)
+		fs.SetOutput(io.Discard)
+		member := def
+		enum.FlagVar(fs, &member, "status", Statuses, "account status")
+		return fs, &member
+	}
+	active := Statuses.Member(string(Active)).MustOk()
+	retired := Statuses.Member(string(Retired)).MustOk()
+
+	fs, got := newFlagSet(active)
+	if err := fs.Parse([]string{"-status", "retired"}); err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+	if *got != retired {
+		t.Errorf("flag = %v, want %v", *got, retired)
+	}
+
+	fs, got = newFlagSet(active)
+	if err := fs.Parse(nil); err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+	if *got != active {
+		t.Errorf("flag default = %v, want %v", *got, active)
+	}
+
+	fs, _ = newFlagSet(active)
+	// the flag package formats rather than wraps the error
+	if err := fs.Parse([]string{"-status", "pending"}); err == nil || !strings.Contains(err.Error(), "allowed: active, retired") {
+		t.Errorf("Parse() error = %v, want it to list the allowed names", err)
+	}
+}
+
+func TestFlagVar_usage(t *testing.T) {
+	tests := []struct {
+		name string
+		def  enum.Member[status, statusInfo]
+		want string
+	}{
+		{"default", Statuses.Member(string(Active)).MustOk(), "account status (one of: active, retired) (default active)"},
+		{"no default", enum.Member[status, statusInfo]{}, "account status (one of: active, retired)\n"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var out strings.Builder
+			fs := flag.NewFlagSet("test", flag.ContinueOnError)
+			fs.SetOutput(&out)
+			member := tt.def
+			enum.FlagVar(fs, &member, "status", Statuses, "account status")
+			fs.PrintDefaults()
+			if !strings.Contains(out.String(), tt.want) {
+				t.Errorf("PrintDefaults() = %q, want it to contain %q", out.String(), tt.want)
+			}
+		})
+	}
+}
+
+func TestMember_UnmarshalText(t *testing.T) {
+	var got enum.Member[status, statusInfo]
+	if err := got.UnmarshalText([]byte("active")); err != nil {
+		t.Fatalf("UnmarshalText() error = %v", err)
+	}
+	if want := Statuses.Member(string(Active)).MustOk(); got != want {
+		t.Errorf("UnmarshalText() = %v, want %v", got, want)
+	}
+	if err := got.UnmarshalText([]byte("pending")); !errors.Is(err, enum.ErrNotMember) {
+		t.Errorf("UnmarshalText() error = %v, want %v", err, enum.ErrNotMember)
+	}
+	if text, _ := got.MarshalText(); string(text) != "active" {
+		t.Errorf("MarshalText() = %s, want %v", text, "active")
+	}
+	// the zero Member round-trips through empty text
+	text, _ := enum.Member[status, statusInfo]{}.MarshalText()
+	if err := got.UnmarshalText(text); err != nil || got != Statuses.Member(string(Active)).MustOk() {
+		t.Errorf("UnmarshalText(%q) = %v %v, want unchanged", text, got, err)
+	}
+}
@@ -0,0 +1,149 @@
+package enum
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/bits-and-blooms/bitset"
+
+	"github.com/binaryphile/valor/result"
+)
+
+type (
+	// Flags is a combination of members of an Enum, each of which is a bit,
+	// such as permissions written as "read|write".
+	// Member i of the enum, by ordinal, is bit 1<<i.
+	// Like Set, on which it is built, Flags is a value.
+	Flags[T ~string, A any] struct {
+		set Set[T, A]
+	}
+)
+
+// FlagsOf creates Flags of members of enum.
+func FlagsOf[T ~string, A any](enum Enum[T, A], members ...Member[T, A]) Flags[T, A] {
+	return Flags[T, A]{set: SetOf(enum, members...)}
+}
+
+// FlagsOfBits creates Flags of the members of enum whose bits are set in bits.
+// Bits without a member are ignored.
+func FlagsOfBits[T ~string, A any](enum Enum[T, A], bits uint64) Flags[T, A] {
+	n := uint(len(enum.Members()))
+
+	set := Set[T, A]{
+		enum: enum,
+		bits: bitset.From([]uint64{bits}),
+	}
+
+	if n < 64 {
+		set.bits = set.bits.Intersection(bitset.New(n).FlipRange(0, n))
+	}
+
+	return Flags[T, A]{set: set}
+}
+
+// ParseFlags returns a Result of the Flags named in s, such as "read|write".
+// Names are separated by "|" and may be surrounded by white space; each is parsed as by Enum.Parse.
+// The empty string has no flags.
+func ParseFlags[T ~string, A any](enum Enum[T, A], s string) result.Result[Flags[T, A]] {
+	flags := FlagsOf(enum)
+
+	if strings.TrimSpace(s) == "" {
+		return result.OfOk(flags)
+	}
+
+	for _, name := range strings.Split(s, "|") {
+		member, err := enum.Parse(strings.TrimSpace(name)).Unpack()
+		if err != nil {
+			return result.OfError[Flags[T, A]](err)
+		}
+
+		flags = flags.Set(member)
+	}
+
+	return result.OfOk(flags)
+}
+
+// Has returns whether member is set in x.
+func (x Flags[T, A]) Has(member Member[T, A]) bool {
+	return x.set.Contains(member)
+}
+
+// Set returns Flags with members set in addition to those of x.
+func (x Flags[T, A]) Set(members ...Member[T, A]) Flags[T, A] {
+	return Flags[T, A]{set: x.set.Add(members...)}
+}
+
+// Clear returns Flags with members cleared from those of x.
+func (x Flags[T, A]) Clear(members ...Member[T, A]) Flags[T, A] {
+	return Flags[T, A]{set: x.set.Remove(members...)}
+}
+
+// Members returns the members set in x in declaration order.
+func (x Flags[T, A]) Members() []Member[T, A] {
+	return x.set.Members()
+}
+
+// Bits returns x as a bitmask.
+// Members with an ordinal of 64 or more are not represented.
+func (x Flags[_, _]) Bits() uint64 {
+	var bits uint64
+
+	for _, member := range x.set.Members() {
+		if member.ordinal < 64 {
+			bits |= 1 << member.ordinal
+		}
+	}
+
+	return bits
+}
+
+// String returns the names of the members set in x, separated by "|".
+func (x Flags[_, _]) String() string {
+	return strings.Join(x.set.Names(), "|")
+}
+
+// MarshalJSON encodes x as an array of names in declaration order.
+func (x Flags[_, _]) MarshalJSON() ([]byte, error) {
+	return x.set.MarshalJSON()
+}
+
+// UnmarshalJSON decodes an array of names, or a string of names separated by "|", into x.
+// The enum is found as for Set.UnmarshalJSON.
+// Does nothing if data is the literal null.
+func (x *Flags[T, A]) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		// by convention, null is no-op
+		return nil
+	}
+
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return x.set.UnmarshalJSON(data)
+	}
+
+	return x.UnmarshalText([]byte(s))
+}
+
+// MarshalText encodes x as its String.
+func (x Flags[_, _]) MarshalText() ([]byte, error) {
+	return []byte(x.String()), nil
+}
+
+// UnmarshalText decodes names separated by "|" into x.
+// The enum is found as for Set.UnmarshalJSON.
+func (x *Flags[T, A]) UnmarshalText(text []byte) error {
+	enum, err := Member[T, A]{enum: x.set.enum}.decodingEnum().Unpack()
+	if err != nil {
+		return err
+	}
+
+	flags, err := ParseFlags(enum, string(text)).Unpack()
+	if err != nil {
+		return fmt.Errorf("flags %q: %w", text, err)
+	}
+
+	*x = flags
+
+	return nil
+}
@@ -0,0 +1,154 @@
+package enum_test
+
+import (
+	"encoding"
+	"encoding/json"
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/binaryphile/valor/enum"
+)
+
+// type checks
+var (
+	_ json.Marshaler           = enum.Flags[permission, struct{}]{}
+	_ json.Unmarshaler         = &enum.Flags[permission, struct{}]{}
+	_ encoding.TextMarshaler   = enum.Flags[permission, struct{}]{}
+	_ encoding.TextUnmarshaler = &enum.Flags[permission, struct{}]{}
+)
+
+func TestFlags(t *testing.T) {
+	rw := enum.FlagsOf(Permissions, Read).Set(Write)
+	if !rw.Has(Read) || !rw.Has(Write) || rw.Has(Admin) {
+		t.Errorf("Has() = %v %v %v, want %v %v %v", rw.Has(Read), rw.Has(Write), rw.Has(Admin), true, true, false)
+	}
+	if got, want := rw.String(), "read|write"; got != want {
+		t.Errorf("String() = %v, want %v", got, want)
+	}
+	if got, want := rw.Bits(), uint64(0b0011); got != want {
+		t.Errorf("Bits() = %b, want %b", got, want)
+	}
+
+	w := rw.Clear(Read)
+	if got, want := w.String(), "write"; got != want {
+		t.Errorf("Clear() = %v, want %v", got, want)
+	}
+	if !rw.Has(Read) {
+		t.Errorf("Clear() modified receiver")
+	}
+
+	if got, want := enum.FlagsOf(Permissions).String(), ""; got != want {
+		t.Errorf("String() = %q, want %q", got, want)
+	}
+}
+
+func TestFlagsOfBits(t *testing.T) {
+	tests := []struct {
+		name string
+		bits uint64
+		want []enum.Member[permission, struct{}]
+	}{
+		{name: "none", bits: 0, want: []enum.Member[permission, struct{}]{}},
+		{name: "some", bits: 0b1010, want: []enum.Member[permission, struct{}]{Write, Admin}},
+		{name: "unused bits ignored", bits: 0xF1, want: []enum.Member[permission, struct{}]{Read}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := enum.FlagsOfBits(Permissions, tt.bits)
+			if !reflect.DeepEqual(got.Members(), tt.want) {
+				t.Errorf("FlagsOfBits() = %v, want %v", got.Members(), tt.want)
+			}
+			if got.Bits() != tt.bits&0b1111 {
+				t.Errorf("Bits() = %b, want %b", got.Bits(), tt.bits&0b1111)
+			}
+		})
+	}
+}
+
+func TestParseFlags(t *testing.T) {
+	tests := []struct {
+		name    string
+		s       string
+		want    string
+		wantErr error
+	}{
+		{name: "one", s: "admin", want: "admin"},
+		{name: "several", s: "delete|read", want: "read|delete"},
+		{name: "spaced", s: " read | write ", want: "read|write"},
+		{name: "repeated", s: "read|read", want: "read"},
+		{name: "empty", s: "", want: ""},
+		{name: "unknown", s: "read|execute", wantErr: enum.ErrNotMember},
+		{name: "empty name", s: "read|", wantErr: enum.ErrNotMember},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := enum.ParseFlags(Permissions, tt.s).Unpack()
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("ParseFlags() error = %v, want %v", err, tt.wantErr)
+			}
+			if err == nil && got.String() != tt.want {
+				t.Errorf("ParseFlags() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFlags_JSON(t *testing.T) {
+	type file struct {
+		Mode enum.Flags[permission, struct{}] `json:"mode"`
+	}
+
+	data, err := json.Marshal(file{Mode: enum.FlagsOf(Permissions, Write, Read)})
+	if err != nil {
+		t.Fatalf("Marshal() error = %v", err)
+	}
+	if got, want := string(data), `{"mode":["read","write"]}`; got != want {
+		t.Errorf("Marshal() = %v, want %v", got, want)
+	}
+
+	for _, input := range []string{`{"mode":["read","write"]}`, `{"mode":"read|write"}`} {
+		var got file
+		if err := json.Unmarshal([]byte(input), &got); err != nil {
+			t.Fatalf("Unmarshal(%s) error = %v", input, err)
+		}
+		if got, want := got.Mode.String(), "read|write"; got != want {
+			t.Errorf("Unmarshal(%s) = %v, want %v", input, got, want)
+		}
+	}
+
+	got := file{Mode: enum.FlagsOf(Permissions, Read)}
+	if err := json.Unmarshal([]byte(`{"mode":null}`), &got); err != nil || got.Mode.String() != "read" {
+		t.Errorf("Unmarshal(null) = %v %v, want unchanged %v", got.Mode, err, "read")
+	}
+
+	type unbound string
+	var zero enum.Flags[unbound, struct{}]
+	if err := json.Unmarshal([]byte(`null`), &zero); err != nil {
+		t.Errorf("Unmarshal(null) without Enum error = %v, want nil", err)
+	}
+
+	if err := json.Unmarshal([]byte(`{"mode":"read|execute"}`), &got); !errors.Is(err, enum.ErrNotMember) {
+		t.Errorf("Unmarshal() error = %v, want %v", err, enum.ErrNotMember)
+	}
+}
+
+func TestFlags_Text(t *testing.T) {
+	text, err := enum.FlagsOf(Permissions, Admin, Read).MarshalText()
+	if err != nil {
+		t.Fatalf("MarshalText() error = %v", err)
+	}
+	if got, want := string(text), "read|admin"; got != want {
+		t.Errorf("MarshalText() = %v, want %v", got, want)
+	}
+
+	var got enum.Flags[permission, struct{}]
+	if err := got.UnmarshalText(text); err != nil {
+		t.Fatalf("UnmarshalText() error = %v", err)
+	}
+	if got.Bits() != enum.FlagsOf(Permissions, Read, Admin).Bits() {
+		t.Errorf("UnmarshalText() = %v, want %v", got, "read|admin")
+	}
+}
@@ -0,0 +1,451 @@
+package enum
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync/atomic"
+
+	"github.com/binaryphile/valor/optional"
+	"github.com/binaryphile/valor/result"
+)
+
+type (
+	// Integer is the constraint for the codes of an IntEnum.
+	Integer interface {
+		~int | ~int8 | ~int16 | ~int32 | ~int64 |
+			~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64
+	}
+
+	// IntEnum is an enum of integer codes, each with a name.
+	// It is an Enum of the names whose members also have codes,
+	// so it offers the same lookups and its members the same methods.
+	IntEnum[T Integer, A any] struct {
+		enum  Enum[string, intAttr[T, A]]
+		codec *intCodec
+	}
+
+	// IntMember is a member of an IntEnum.
+	IntMember[T Integer, A any] struct {
+		member Member[string, intAttr[T, A]]
+	}
+
+	// IntDef defines a member of an IntEnum.
+	IntDef[T Integer, A any] struct {
+		Value T
+		Name  string
+		Attr  A
+	}
+
+	// intAttr is the attribute of the members of the Enum underlying an IntEnum.
+	intAttr[T Integer, A any] struct {
+		code  T
+		attr  A
+		codec *intCodec
+	}
+
+	// intCodec holds the encoding settings of an IntEnum.
+	intCodec struct {
+		names atomic.Bool
+	}
+)
+
+// OfInts creates an IntEnum of the given codes and names.
+// It also returns a function for generating members, as Of does.
+// A code repeated in defs or given again to the function is not added twice;
+// the member with that code is returned instead.
+// It panics if a name is repeated with another code, or once the enum is sealed.
+func OfInts[T Integer, A any](defs ...IntDef[T, A]) (IntEnum[T, A], func(IntDef[T, A]) IntMember[T, A]) {
+	enum, _ := OfDefs[string, intAttr[T, A]]()
+
+	x := IntEnum[T, A]{
+		enum:  enum,
+		codec: &intCodec{},
+	}
+
+	add := func(def IntDef[T, A]) IntMember[T, A] {
+		var member IntMember[T, A]
+
+		// the checks and the insert share the lock so concurrent calls cannot both add a code
+		if err := enum.table.change(func() error {
+			for i, attr := range enum.table.attrs {
+				if attr.code == def.Value {
+					member = IntMember[T, A]{member: enum.table.ordinal(i)}
+
+					return nil
+				}
+			}
+
+			if i, ok := enum.table.index[def.Name]; ok {
+				return fmt.Errorf("name has code %v, not %v", enum.table.attrs[i].code, def.Value)
+			}
+
+			member = IntMember[T, A]{
+				member: enum.table.add(enum, DefOf(def.Name, intAttr[T, A]{
+					code:  def.Value,
+					attr:  def.Attr,
+					codec: x.codec,
+				})),
+			}
+
+			return nil
+		}); err != nil {
+			panic(fmt.Errorf("%q: %w", def.Name, err))
+		}
+
+		return member
+	}
+
+	for _, def := range defs {
+		add(def)
+	}
+
+	return x, add
+}
+
+// EncodeNames sets whether members encode as their names rather than their codes.
+// Decoding accepts either.
+// Like the other changes to an enum, it panics once x is sealed.
+func (x IntEnum[_, _]) EncodeNames(names bool) {
+	if x.enum.table == nil {
+		return
+	}
+
+	mustChange(x.enum.table.change(func() error {
+		x.codec.names.Store(names)
+
+		return nil
+	}))
+}
+
+// Seal prevents further changes to x. See Enum.Seal.
+func (x IntEnum[_, _]) Seal() {
+	x.enum.Seal()
+}
+
+// Sealed returns whether x is sealed.
+func (x IntEnum[_, _]) Sealed() bool {
+	return x.enum.Sealed()
+}
+
+// Bind makes x the IntEnum used to decode zero IntMembers of its type.
+// See Enum.Bind.
+func (x IntEnum[_, _]) Bind() {
+	x.enum.Bind()
+}
+
+// SetNormalizer sets the Normalizer Parse applies to names. See Enum.SetNormalizer.
+func (x IntEnum[_, _]) SetNormalizer(normalize Normalizer) {
+	x.enum.SetNormalizer(normalize)
+}
+
+// Alias adds aliases that Parse accepts as names of member. See Enum.Alias.
+func (x IntEnum[T, A]) Alias(member IntMember[T, A], aliases ...string) error {
+	return x.enum.Alias(member.member, aliases...)
+}
+
+// Deprecate marks member as deprecated in favor of replacement. See Enum.Deprecate.
+func (x IntEnum[T, A]) Deprecate(member, replacement IntMember[T, A]) error {
+	return x.enum.Deprecate(member.member, replacement.member)
+}
+
+// OnDeprecated adds hook to those called when Parse accepts the name or code of a deprecated member.
+// See Enum.OnDeprecated.
+func (x IntEnum[T, A]) OnDeprecated(hook func(used, replacement IntMember[T, A])) {
+	x.enum.OnDeprecated(func(used, replacement Member[string, intAttr[T, A]]) {
+		hook(intMemberOf[T, A](used), intMemberOf[T, A](replacement))
+	})
+}
+
+// ActiveNames returns the names of the members that are not deprecated in declaration order.
+func (x IntEnum[_, _]) ActiveNames() []string {
+	return x.enum.ActiveNames()
+}
+
+func (x IntEnum[_, _]) Includes(name string) bool {
+	return x.enum.Includes(name)
+}
+
+// Names returns the names of the members in declaration order.
+func (x IntEnum[_, _]) Names() []string {
+	return x.enum.Names()
+}
+
+// Members returns the members in declaration order.
+func (x IntEnum[T, A]) Members() []IntMember[T, A] {
+	members := x.enum.Members()
+	result := make([]IntMember[T, A], len(members))
+
+	for i, member := range members {
+		result[i] = IntMember[T, A]{member: member}
+	}
+
+	return result
+}
+
+// Member returns the member named name.
+func (x IntEnum[T, A]) Member(name string) optional.Value[IntMember[T, A]] {
+	return optional.Map(intMemberOf[T, A], x.enum.Member(name))
+}
+
+// Code returns the member with code.
+func (x IntEnum[T, A]) Code(code T) optional.Value[IntMember[T, A]] {
+	if x.enum.table == nil {
+		return optional.OfNotOk[IntMember[T, A]]()
+	}
+
+	x.enum.table.mu.RLock()
+	defer x.enum.table.mu.RUnlock()
+
+	for i, attr := range x.enum.table.attrs {
+		if attr.code == code {
+			return optional.OfOk(IntMember[T, A]{member: x.enum.table.ordinal(i)})
+		}
+	}
+
+	return optional.OfNotOk[IntMember[T, A]]()
+}
+
+// Parse returns a Result of the member named s or, if s is a decimal integer, with that code.
+// Names are matched, and deprecated members replaced, as for Enum.Parse.
+// If there is no such member, the error wraps ErrNotMember and lists the active names and codes.
+func (x IntEnum[T, A]) Parse(s string) result.Result[IntMember[T, A]] {
+	if member := optional.Map(intMemberOf[T, A], x.enum.lookup(s)).SelfOrTake(func() optional.Value[IntMember[T, A]] {
+		return optional.FlatMap(x.Code, parseCode[T](s))
+	}); member.IsOk() {
+		return result.OfOk(intMemberOf[T, A](x.enum.resolve(member.MustOk().member)))
+	}
+
+	active := x.enum.ActiveMembers()
+
+	allowed := make([]string, 0, len(active))
+	for _, member := range active {
+		allowed = append(allowed, fmt.Sprintf("%s (%v)", member.value, member.Attr().code))
+	}
+
+	return result.OfError[IntMember[T, A]](fmt.Errorf("%q: %w (allowed: %s)", s, ErrNotMember, strings.Join(allowed, ", ")))
+}
+
+// Code returns the code of x.
+func (x IntMember[T, _]) Code() T {
+	return x.attr().code
+}
+
+// Attr returns the attribute x was defined with.
+func (x IntMember[_, A]) Attr() A {
+	return x.attr().attr
+}
+
+func (x IntMember[_, _]) Name() string {
+	return x.member.Name()
+}
+
+func (x IntMember[_, _]) String() string {
+	return x.member.String()
+}
+
+func (x IntMember[_, _]) Error() string {
+	return x.member.Error()
+}
+
+// Is returns whether target is an IntMember of the same enum with the same code as x.
+// See Member.Is.
+func (x IntMember[T, A]) Is(target error) bool {
+	other, ok := target.(IntMember[T, A])
+
+	return ok && x.member.enum.table != nil && x.member.enum.table == other.member.enum.table && x.Code() == other.Code()
+}
+
+func (x IntMember[T, A]) Enum() IntEnum[T, A] {
+	return IntEnum[T, A]{
+		enum:  x.member.enum,
+		codec: x.attr().codec,
+	}
+}
+
+// Ordinal returns the position of x in the declaration order of its enum. See Member.Ordinal.
+func (x IntMember[_, _]) Ordinal() int {
+	return x.member.Ordinal()
+}
+
+// Next returns the member declared after x. See Member.Next.
+func (x IntMember[T, A]) Next() optional.Value[IntMember[T, A]] {
+	return optional.Map(intMemberOf[T, A], x.member.Next())
+}
+
+// Prev returns the member declared before x. See Member.Prev.
+func (x IntMember[T, A]) Prev() optional.Value[IntMember[T, A]] {
+	return optional.Map(intMemberOf[T, A], x.member.Prev())
+}
+
+// Compare orders x and other by ordinal. See Member.Compare.
+func (x IntMember[T, A]) Compare(other IntMember[T, A]) int {
+	return x.member.Compare(other.member)
+}
+
+// Deprecated returns whether x is deprecated.
+func (x IntMember[_, _]) Deprecated() bool {
+	return x.member.Deprecated()
+}
+
+// Replacement returns the member that replaces x, if x is deprecated and has a replacement.
+func (x IntMember[T, A]) Replacement() optional.Value[IntMember[T, A]] {
+	return optional.Map(intMemberOf[T, A], x.member.Replacement())
+}
+
+// MarshalJSON encodes x as its code, or as its name if the enum encodes names.
+// The zero IntMember encodes as the literal null.
+func (x IntMember[_, _]) MarshalJSON() ([]byte, error) {
+	if x.member.enum.table == nil {
+		return []byte("null"), nil
+	}
+
+	if x.attr().codec.names.Load() {
+		return json.Marshal(x.Name())
+	}
+
+	return json.Marshal(x.Code())
+}
+
+// UnmarshalJSON decodes a code or a name into x.
+// It is an error if there is no such member.
+// The enum is found as for Member.UnmarshalJSON.
+// Does nothing if data is the literal null.
+func (x *IntMember[T, A]) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		// by convention, null is no-op
+		return nil
+	}
+
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		// not a string, so decode as a code
+		var code T
+		if err := json.Unmarshal(data, &code); err != nil {
+			return err
+		}
+
+		s = fmt.Sprint(code)
+	}
+
+	return x.parse(s)
+}
+
+// MarshalText encodes x as its code, or as its name if the enum encodes names.
+func (x IntMember[_, _]) MarshalText() ([]byte, error) {
+	if x.member.enum.table == nil {
+		return nil, nil
+	}
+
+	if x.attr().codec.names.Load() {
+		return []byte(x.Name()), nil
+	}
+
+	return []byte(fmt.Sprint(x.Code())), nil
+}
+
+// EncodedValues returns the JSON values of the members of the enum of x in declaration order,
+// either codes or names.
+// The enum is found as for UnmarshalJSON.
+// Returns nil if there is no such enum.
+func (x IntMember[T, A]) EncodedValues() []any {
+	enum, err := x.member.decodingEnum().Unpack()
+	if err != nil {
+		return nil
+	}
+
+	members := IntEnum[T, A]{enum: enum}.Members()
+
+	values := make([]any, len(members))
+	for i, member := range members {
+		if member.attr().codec.names.Load() {
+			values[i] = member.Name()
+		} else {
+			values[i] = member.Code()
+		}
+	}
+
+	return values
+}
+
+// UnmarshalText decodes a code or a name into x.
+// Does nothing if text is empty, which is how the zero IntMember encodes.
+func (x *IntMember[T, A]) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		// like null in JSON, empty text is no-op
+		return nil
+	}
+
+	return x.parse(string(text))
+}
+
+// Value encodes x as its code, or as its name if the enum encodes names, for use as a query argument.
+// The zero IntMember encodes as NULL.
+func (x IntMember[_, _]) Value() (driver.Value, error) {
+	if x.member.enum.table == nil {
+		return nil, nil
+	}
+
+	if x.attr().codec.names.Load() {
+		return x.Name(), nil
+	}
+
+	return driver.DefaultParameterConverter.ConvertValue(x.Code())
+}
+
+// Scan decodes a code from an integer column, or a code or name from a text column, into x.
+// NULL decodes as the zero IntMember.
+func (x *IntMember[T, A]) Scan(src any) error {
+	switch src := src.(type) {
+	case nil:
+		*x = IntMember[T, A]{}
+
+		return nil
+	case int64:
+		return x.parse(strconv.FormatInt(src, 10))
+	case string:
+		return x.parse(src)
+	case []byte:
+		return x.parse(string(src))
+	}
+
+	return fmt.Errorf("cannot scan %T into %T", src, *x)
+}
+
+// attr returns the attribute of the underlying member.
+func (x IntMember[T, A]) attr() intAttr[T, A] {
+	return x.member.Attr()
+}
+
+// parse sets x to the member with the code or name s.
+func (x *IntMember[T, A]) parse(s string) error {
+	member, err := result.FlatMap(func(enum Enum[string, intAttr[T, A]]) result.Result[IntMember[T, A]] {
+		return IntEnum[T, A]{enum: enum}.Parse(s)
+	}, x.member.decodingEnum()).Unpack()
+	if err != nil {
+		return err
+	}
+
+	*x = member
+
+	return nil
+}
+
+func intMemberOf[T Integer, A any](member Member[string, intAttr[T, A]]) IntMember[T, A] {
+	return IntMember[T, A]{member: member}
+}
+
+// parseCode returns the code in s, if s is a decimal integer that fits in T.
+func parseCode[T Integer](s string) optional.Value[T] {
+	if n, err := strconv.ParseInt(s, 10, 64); err == nil {
+		return optional.Of(T(n), int64(T(n)) == n && (n >= 0) == (T(n) >= 0))
+	}
+
+	if n, err := strconv.ParseUint(s, 10, 64); err == nil {
+		return optional.Of(T(n), uint64(T(n)) == n && T(n) >= 0)
+	}
+
+	return optional.OfNotOk[T]()
+}
@@ -0,0 +1,231 @@
+package enum_test
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"encoding"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"sync"
+	"testing"
+
+	"github.com/binaryphile/valor/enum"
+	"github.com/binaryphile/valor/optional"
+)
+
+type opcode uint8
+
+// type checks
+var (
+	_ json.Marshaler           = enum.IntMember[opcode, string]{}
+	_ json.Unmarshaler         = &enum.IntMember[opcode, string]{}
+	_ encoding.TextMarshaler   = enum.IntMember[opcode, string]{}
+	_ encoding.TextUnmarshaler = &enum.IntMember[opcode, string]{}
+	_ driver.Valuer            = enum.IntMember[opcode, string]{}
+	_ sql.Scanner              = &enum.IntMember[opcode, string]{}
+)
+
+func newOpcodes() (enum.IntEnum[opcode, string], func(enum.IntDef[opcode, string]) enum.IntMember[opcode, string]) {
+	return enum.OfInts(
+		enum.IntDef[opcode, string]{Value: 1, Name: "query", Attr: "read"},
+		enum.IntDef[opcode, string]{Value: 4, Name: "notify", Attr: "push"},
+		enum.IntDef[opcode, string]{Value: 5, Name: "update", Attr: "write"},
+	)
+}
+
+func ExampleOfInts() {
+	opcodes, _ := newOpcodes()
+	update := opcodes.Code(5).MustOk()
+	fmt.Println(update, update.Code(), update.Attr())
+
+	b, _ := json.Marshal(update)
+	fmt.Println(string(b))
+
+	opcodes.EncodeNames(true)
+	b, _ = json.Marshal(update)
+	fmt.Println(string(b))
+	// Output:
+	// update 5 write
+	// 5
+	// "update"
+}
+
+func TestOfInts(t *testing.T) {
+	opcodes, generate := newOpcodes()
+
+	if got, want := opcodes.Names(), []string{"query", "notify", "update"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Names() = %v, want %v", got, want)
+	}
+	if got := generate(enum.IntDef[opcode, string]{Value: 4, Name: "status"}); got != opcodes.Code(4).MustOk() {
+		t.Errorf("generate() of existing code = %v, want %v", got, opcodes.Code(4).MustOk())
+	}
+	added := generate(enum.IntDef[opcode, string]{Value: 6, Name: "dso"})
+	if added.Ordinal() != 3 || added.Code() != 6 {
+		t.Errorf("generate() = %v %v, want %v %v", added.Ordinal(), added.Code(), 3, 6)
+	}
+	if got := opcodes.Code(2); got.IsOk() {
+		t.Errorf("Code() = %v, want not ok", got)
+	}
+	if got := opcodes.Code(1).MustOk().Next(); got != opcodes.Member("notify") {
+		t.Errorf("Next() = %v, want %v", got, opcodes.Member("notify"))
+	}
+	if got := opcodes.Member("query").MustOk().Enum(); got != opcodes {
+		t.Errorf("Enum() = %v, want %v", got, opcodes)
+	}
+}
+
+func TestOfInts_nameConflict(t *testing.T) {
+	_, generate := newOpcodes()
+
+	defer func() {
+		if err, _ := recover().(error); err == nil {
+			t.Errorf("generate() of existing name with another code panic = %v, want error", err)
+		}
+	}()
+	generate(enum.IntDef[opcode, string]{Value: 7, Name: "query"})
+}
+
+func TestOfInts_concurrent(t *testing.T) {
+	opcodes, generate := enum.OfInts[opcode, string]()
+
+	const n = 50
+	var wg sync.WaitGroup
+	members := make([]enum.IntMember[opcode, string], n)
+	for i := 0; i < n; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			members[i] = generate(enum.IntDef[opcode, string]{Value: 9, Name: fmt.Sprint("op", i)})
+		}(i)
+	}
+	wg.Wait()
+
+	if got := len(opcodes.Members()); got != 1 {
+		t.Errorf("len(Members()) = %v, want %v", got, 1)
+	}
+	for _, member := range members {
+		if member != members[0] {
+			t.Errorf("generate() = %v, want %v", member, members[0])
+		}
+	}
+}
+
+func TestIntEnum_Parse(t *testing.T) {
+	opcodes, _ := newOpcodes()
+	opcodes.SetNormalizer(enum.FoldCase)
+	update := opcodes.Code(5).MustOk()
+
+	for _, s := range []string{"update", "UPDATE", "5"} {
+		if got := opcodes.Parse(s).Value(); got != optional.OfOk(update) {
+			t.Errorf("Parse(%q) = %v, want %v", s, got, update)
+		}
+	}
+
+	got := opcodes.Parse("300")
+	if want := `"300": not a member of the enum (allowed: query (1), notify (4), update (5))`; got.Error() == nil || got.Error().Error() != want {
+		t.Errorf("Parse() error = %v, want %v", got.Error(), want)
+	}
+	if !opcodes.Parse("-1").ErrorIs(enum.ErrNotMember) {
+		t.Errorf("Parse(-1) = %v, want %v", opcodes.Parse("-1"), enum.ErrNotMember)
+	}
+}
+
+func TestIntMember_JSON(t *testing.T) {
+	opcodes, _ := newOpcodes()
+	opcodes.Bind()
+	notify := opcodes.Code(4).MustOk()
+
+	var got struct {
+		Op enum.IntMember[opcode, string] `json:"op"`
+	}
+	for _, data := range []string{`{"op":4}`, `{"op":"notify"}`} {
+		got.Op = enum.IntMember[opcode, string]{}
+		if err := json.Unmarshal([]byte(data), &got); err != nil || got.Op != notify {
+			t.Errorf("UnmarshalJSON(%s) = %v %v, want %v", data, got.Op, err, notify)
+		}
+	}
+	for _, data := range []string{`{"op":2}`, `{"op":"status"}`} {
+		if err := json.Unmarshal([]byte(data), &got); !errors.Is(err, enum.ErrNotMember) {
+			t.Errorf("UnmarshalJSON(%s) error = %v, want %v", data, err, enum.ErrNotMember)
+		}
+	}
+	if err := json.Unmarshal([]byte(`{"op":true}`), &got); err == nil {
+		t.Errorf("UnmarshalJSON() error = %v, want error", err)
+	}
+
+	b, err := json.Marshal(struct {
+		Op enum.IntMember[opcode, string] `json:"op"`
+	}{})
+	if err != nil || string(b) != `{"op":null}` {
+		t.Errorf("MarshalJSON() = %s %v, want %v", b, err, `{"op":null}`)
+	}
+
+	text, _ := enum.IntMember[opcode, string]{}.MarshalText()
+	if err := got.Op.UnmarshalText(text); err != nil || got.Op != notify {
+		t.Errorf("UnmarshalText(%q) = %v %v, want unchanged", text, got.Op, err)
+	}
+}
+
+func TestIntMember_EncodedValues(t *testing.T) {
+	opcodes, _ := newOpcodes()
+	query := opcodes.Code(1).MustOk()
+
+	if got, want := query.EncodedValues(), []any{opcode(1), opcode(4), opcode(5)}; !reflect.DeepEqual(got, want) {
+		t.Errorf("EncodedValues() = %v, want %v", got, want)
+	}
+
+	opcodes.EncodeNames(true)
+	if got, want := query.EncodedValues(), []any{"query", "notify", "update"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("EncodedValues() with names = %v, want %v", got, want)
+	}
+}
+
+func TestIntMember_SQL(t *testing.T) {
+	opcodes, _ := newOpcodes()
+	query := opcodes.Code(1).MustOk()
+
+	if got, err := query.Value(); got != int64(1) || err != nil {
+		t.Errorf("Value() = %v %v, want %v %v", got, err, int64(1), nil)
+	}
+
+	for _, src := range []any{int64(1), "1", []byte("query")} {
+		got := opcodes.Code(5).MustOk()
+		if err := got.Scan(src); err != nil || got != query {
+			t.Errorf("Scan(%v) = %v %v, want %v", src, got, err, query)
+		}
+	}
+
+	got := query
+	if err := got.Scan(nil); err != nil || got != (enum.IntMember[opcode, string]{}) {
+		t.Errorf("Scan(nil) = %v %v, want zero", got, err)
+	}
+	got = query
+	if err := got.Scan(int64(256)); !errors.Is(err, enum.ErrNotMember) {
+		t.Errorf("Scan(256) error = %v, want %v", err, enum.ErrNotMember)
+	}
+
+	opcodes.EncodeNames(true)
+	if got, err := query.Value(); got != "query" || err != nil {
+		t.Errorf("Value() = %v %v, want %v %v", got, err, "query", nil)
+	}
+}
+
+func TestIntMember_Is(t *testing.T) {
+	opcodes, _ := newOpcodes()
+	query := opcodes.Code(1).MustOk()
+	err := fmt.Errorf("refused: %w", query)
+
+	if !errors.Is(err, query) {
+		t.Errorf("errors.Is() = %v, want %v", false, true)
+	}
+	if errors.Is(err, opcodes.Code(4).MustOk()) {
+		t.Errorf("errors.Is() = %v, want %v", true, false)
+	}
+
+	others, _ := newOpcodes()
+	if errors.Is(err, others.Code(1).MustOk()) {
+		t.Errorf("errors.Is() of other enum = %v, want %v", true, false)
+	}
+}
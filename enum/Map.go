@@ -0,0 +1,96 @@
+package enum
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/binaryphile/valor/optional"
+	"github.com/binaryphile/valor/result"
+)
+
+// ErrIncomplete is the error for a mapping that does not cover every member of an Enum.
+var ErrIncomplete = errors.New("not every member is covered")
+
+type (
+	// Map holds one value for each member of an Enum, stored densely by ordinal.
+	Map[T ~string, A any, V any] struct {
+		enum   Enum[T, A]
+		values []V
+	}
+)
+
+// MapOf creates a Map of the values in entries.
+// It is an error if entries lacks a member of enum;
+// the error wraps ErrIncomplete and lists the missing members.
+// It is also an error if entries has a member of another enum.
+// Members added to enum after the Map is created are not in the Map.
+func MapOf[T ~string, A any, V any](enum Enum[T, A], entries map[Member[T, A]]V) result.Result[Map[T, A, V]] {
+	members := enum.Members()
+
+	for member := range entries {
+		if member.enum != enum {
+			return result.OfError[Map[T, A, V]](fmt.Errorf("%q: %w", member.value, ErrNotMember))
+		}
+	}
+
+	values := make([]V, len(members))
+	missing := make([]string, 0)
+
+	for i, member := range members {
+		value, ok := entries[member]
+		if !ok {
+			missing = append(missing, string(member.value))
+
+			continue
+		}
+
+		values[i] = value
+	}
+
+	if len(missing) > 0 {
+		return result.OfError[Map[T, A, V]](fmt.Errorf("%w (missing: %s)", ErrIncomplete, strings.Join(missing, ", ")))
+	}
+
+	return result.OfOk(Map[T, A, V]{
+		enum:   enum,
+		values: values,
+	})
+}
+
+// MustMapOf is like MapOf but panics with the error if entries is incomplete.
+// This aids in initializing package variables.
+func MustMapOf[T ~string, A any, V any](enum Enum[T, A], entries map[Member[T, A]]V) Map[T, A, V] {
+	x, err := MapOf(enum, entries).Unpack()
+	if err != nil {
+		panic(err)
+	}
+
+	return x
+}
+
+// Get returns the value for member.
+// It panics with an error wrapping ErrNotMember if x has no value for member,
+// because member is the zero Member, is of another enum or was added to the enum after x was created.
+func (x Map[T, A, V]) Get(member Member[T, A]) V {
+	value, ok := x.Lookup(member).Unpack()
+	if !ok {
+		panic(fmt.Errorf("%q: %w", member.value, ErrNotMember))
+	}
+
+	return value
+}
+
+// Lookup returns the value for member, if x has one.
+func (x Map[T, A, V]) Lookup(member Member[T, A]) optional.Value[V] {
+	if member.enum.table == nil || member.enum != x.enum || member.ordinal >= len(x.values) {
+		return optional.OfNotOk[V]()
+	}
+
+	return optional.OfOk(x.values[member.ordinal])
+}
+
+// Enum returns the enum whose members are the keys of x.
+func (x Map[T, A, V]) Enum() Enum[T, A] {
+	return x.enum
+}
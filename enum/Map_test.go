@@ -0,0 +1,93 @@
+package enum_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/binaryphile/valor/enum"
+)
+
+func TestMapOf(t *testing.T) {
+	descriptions := enum.MapOf(Permissions, map[enum.Member[permission, struct{}]]string{
+		Read:   "view",
+		Write:  "edit",
+		Delete: "remove",
+		Admin:  "manage",
+	})
+	if descriptions.IsError() {
+		t.Fatalf("MapOf() error = %v", descriptions.Error())
+	}
+	m := descriptions.Value().MustOk()
+	if got := m.Get(Delete); got != "remove" {
+		t.Errorf("Get() = %v, want %v", got, "remove")
+	}
+	if got := m.Enum(); got != Permissions {
+		t.Errorf("Enum() = %v, want %v", got, Permissions)
+	}
+}
+
+func TestMapOf_incomplete(t *testing.T) {
+	got := enum.MapOf(Permissions, map[enum.Member[permission, struct{}]]int{
+		Write: 1,
+	})
+	if !got.ErrorIs(enum.ErrIncomplete) {
+		t.Errorf("MapOf() = %v, want %v", got, enum.ErrIncomplete)
+	}
+	if want := "not every member is covered (missing: read, delete, admin)"; got.Error() == nil || got.Error().Error() != want {
+		t.Errorf("MapOf() error = %v, want %v", got.Error(), want)
+	}
+}
+
+func TestMapOf_otherEnum(t *testing.T) {
+	others, _ := enum.Of[permission, struct{}]("read")
+
+	got := enum.MapOf(Permissions, map[enum.Member[permission, struct{}]]int{
+		Read: 1, Write: 2, Delete: 3, Admin: 4,
+		others.Member("read").MustOk(): 5,
+	})
+	if !got.ErrorIs(enum.ErrNotMember) {
+		t.Errorf("MapOf() = %v, want %v", got, enum.ErrNotMember)
+	}
+}
+
+func TestMustMapOf(t *testing.T) {
+	defer func() {
+		err, _ := recover().(error)
+		if !errors.Is(err, enum.ErrIncomplete) {
+			t.Errorf("MustMapOf() panicked with %v, want %v", err, enum.ErrIncomplete)
+		}
+	}()
+	enum.MustMapOf(Permissions, map[enum.Member[permission, struct{}]]int{})
+}
+
+func TestMap_Lookup(t *testing.T) {
+	type level string
+	levels, generate := enum.Of[level, struct{}]("low", "high")
+	others, _ := enum.Of[level, struct{}]("low")
+	low := levels.Member("low").MustOk()
+
+	m := enum.MustMapOf(levels, map[enum.Member[level, struct{}]]int{
+		low:                            1,
+		levels.Member("high").MustOk(): 2,
+	})
+	added := generate("critical")
+
+	if got := m.Lookup(low); got.MustOk() != 1 {
+		t.Errorf("Lookup() = %v, want %v", got, 1)
+	}
+
+	for _, member := range []enum.Member[level, struct{}]{{}, others.Member("low").MustOk(), added} {
+		if got := m.Lookup(member); got.IsOk() {
+			t.Errorf("Lookup(%q) = %v, want not ok", member, got)
+		}
+
+		func() {
+			defer func() {
+				if err, _ := recover().(error); !errors.Is(err, enum.ErrNotMember) {
+					t.Errorf("Get(%q) panic = %v, want %v", member, err, enum.ErrNotMember)
+				}
+			}()
+			m.Get(member)
+		}()
+	}
+}
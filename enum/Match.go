@@ -0,0 +1,105 @@
+package enum
+
+import (
+	"github.com/binaryphile/valor/result"
+)
+
+type (
+	// Cases builds a Matcher one member at a time.
+	// Like Set, it is a value: its methods return a new Cases.
+	Cases[R any, T ~string, A any] struct {
+		enum     Enum[T, A]
+		handlers map[Member[T, A]]func(Member[T, A]) R
+		fallback func(Member[T, A]) R
+	}
+
+	// Matcher calls the handler for a member.
+	// Every member of its enum has a handler.
+	Matcher[R any, T ~string, A any] struct {
+		handlers Map[T, A, func(Member[T, A]) R]
+	}
+)
+
+// CasesOf creates an empty Cases for the members of enum.
+// The type of the handlers' result must be given, as in CasesOf[string](Suits).
+func CasesOf[R any, T ~string, A any](enum Enum[T, A]) Cases[R, T, A] {
+	return Cases[R, T, A]{
+		enum:     enum,
+		handlers: make(map[Member[T, A]]func(Member[T, A]) R),
+	}
+}
+
+// Match creates a Matcher of handlers, which must have one for every member of enum.
+// See MapOf for the errors.
+func Match[R any, T ~string, A any](enum Enum[T, A], handlers map[Member[T, A]]func(Member[T, A]) R) result.Result[Matcher[R, T, A]] {
+	return result.Map(func(handlers Map[T, A, func(Member[T, A]) R]) Matcher[R, T, A] {
+		return Matcher[R, T, A]{handlers: handlers}
+	}, MapOf(enum, handlers))
+}
+
+// On returns a Cases with f as the handler for members.
+func (x Cases[R, T, A]) On(f func(Member[T, A]) R, members ...Member[T, A]) Cases[R, T, A] {
+	handlers := make(map[Member[T, A]]func(Member[T, A]) R, len(x.handlers)+len(members))
+
+	for member, handler := range x.handlers {
+		handlers[member] = handler
+	}
+
+	for _, member := range members {
+		handlers[member] = f
+	}
+
+	x.handlers = handlers
+
+	return x
+}
+
+// Default returns a Cases with f as the handler for members without one of their own.
+func (x Cases[R, T, A]) Default(f func(Member[T, A]) R) Cases[R, T, A] {
+	x.fallback = f
+
+	return x
+}
+
+// Match creates a Matcher of the handlers in x.
+// Without a default, it is an error if a member of the enum has no handler;
+// the error wraps ErrIncomplete and lists the missing members.
+func (x Cases[R, T, A]) Match() result.Result[Matcher[R, T, A]] {
+	if x.fallback == nil {
+		return Match(x.enum, x.handlers)
+	}
+
+	x = x.On(x.fallback, x.missing()...)
+
+	return Match(x.enum, x.handlers)
+}
+
+// MustMatch is like Match but panics with the error.
+// This aids in initializing package variables.
+func (x Cases[R, T, A]) MustMatch() Matcher[R, T, A] {
+	matcher, err := x.Match().Unpack()
+	if err != nil {
+		panic(err)
+	}
+
+	return matcher
+}
+
+// Match calls the handler for member and returns its result.
+// It panics with an error wrapping ErrNotMember if member has no handler; see Map.Get.
+func (x Matcher[R, T, A]) Match(member Member[T, A]) R {
+	return x.handlers.Get(member)(member)
+}
+
+// missing returns the members of the enum without a handler.
+func (x Cases[R, T, A]) missing() []Member[T, A] {
+	missing := make([]Member[T, A], 0)
+
+	for _, member := range x.enum.Members() {
+		if _, ok := x.handlers[member]; !ok {
+			missing = append(missing, member)
+		}
+	}
+
+	return missing
+}
@@ -0,0 +1,92 @@
+package enum_test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/binaryphile/valor/enum"
+)
+
+func ExampleCases() {
+	describe := enum.CasesOf[string](Permissions).
+		On(func(enum.Member[permission, struct{}]) string { return "safe" }, Read).
+		Default(func(p enum.Member[permission, struct{}]) string { return p.Name() + " needs review" }).
+		MustMatch()
+
+	for _, p := range Permissions.Members() {
+		fmt.Println(describe.Match(p))
+	}
+	// Output:
+	// safe
+	// write needs review
+	// delete needs review
+	// admin needs review
+}
+
+func TestCases_Match(t *testing.T) {
+	name := func(p enum.Member[permission, struct{}]) string { return p.Name() }
+
+	got := enum.CasesOf[string](Permissions).On(name, Read, Write).Match()
+	if !got.ErrorIs(enum.ErrIncomplete) {
+		t.Errorf("Match() = %v, want %v", got, enum.ErrIncomplete)
+	}
+	if want := "not every member is covered (missing: delete, admin)"; got.Error() == nil || got.Error().Error() != want {
+		t.Errorf("Match() error = %v, want %v", got.Error(), want)
+	}
+
+	got = enum.CasesOf[string](Permissions).On(name, Read, Write, Delete, Admin).Match()
+	if got.IsError() {
+		t.Fatalf("Match() error = %v", got.Error())
+	}
+	if got := got.Value().MustOk().Match(Delete); got != "delete" {
+		t.Errorf("Match() = %v, want %v", got, "delete")
+	}
+}
+
+func TestCases_On(t *testing.T) {
+	one := func(enum.Member[permission, struct{}]) int { return 1 }
+	two := func(enum.Member[permission, struct{}]) int { return 2 }
+
+	base := enum.CasesOf[int](Permissions).On(one, Read, Write, Delete, Admin)
+	overridden := base.On(two, Admin)
+
+	if got := base.MustMatch().Match(Admin); got != 1 {
+		t.Errorf("Match() after On() on copy = %v, want %v", got, 1)
+	}
+	if got := overridden.MustMatch().Match(Admin); got != 2 {
+		t.Errorf("Match() = %v, want %v", got, 2)
+	}
+}
+
+func TestMatch(t *testing.T) {
+	got := enum.Match(Permissions, map[enum.Member[permission, struct{}]]func(enum.Member[permission, struct{}]) bool{
+		Read: func(enum.Member[permission, struct{}]) bool { return true },
+	})
+	if !got.ErrorIs(enum.ErrIncomplete) {
+		t.Errorf("Match() = %v, want %v", got, enum.ErrIncomplete)
+	}
+}
+
+func TestCases_MustMatch(t *testing.T) {
+	defer func() {
+		err, _ := recover().(error)
+		if !errors.Is(err, enum.ErrIncomplete) {
+			t.Errorf("MustMatch() panicked with %v, want %v", err, enum.ErrIncomplete)
+		}
+	}()
+	enum.CasesOf[int](Permissions).MustMatch()
+}
+
+func TestMatcher_Match_zero(t *testing.T) {
+	describe := enum.CasesOf[string](Permissions).
+		Default(func(p enum.Member[permission, struct{}]) string { return p.Name() }).
+		MustMatch()
+
+	defer func() {
+		if err, _ := recover().(error); !errors.Is(err, enum.ErrNotMember) {
+			t.Errorf("Match() of zero Member panic = %v, want %v", err, enum.ErrNotMember)
+		}
+	}()
+	describe.Match(enum.Member[permission, struct{}]{})
+}
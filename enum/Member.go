@@ -1,10 +1,14 @@
 package enum
 
+import (
+	"github.com/binaryphile/valor/optional"
+)
+
 type (
 	Member[T ~string, A any] struct {
-		enum  Enum[T, A]
-		place int
-		value T
+		enum    Enum[T, A]
+		ordinal int
+		value   T
 	}
 )
 
@@ -20,10 +24,73 @@ func (x Member[_, _]) Name() string {
 	return string(x.value)
 }
 
+// Is returns whether other is the same member of the same enum as x.
+// The zero Member is no member, so it is never other.
 func (x Member[T, A]) Is(other Member[T, A]) bool {
-	return x.value == other.value
+	return x.enum.table != nil && x.enum.table == other.enum.table && x.value == other.value
 }
 
 func (x Member[T, A]) Enum() Enum[T, A] {
 	return x.enum
 }
+
+// Attr returns the attribute x was defined with.
+// Returns the zero attribute if x was defined without one.
+func (x Member[T, A]) Attr() (attr A) {
+	if x.enum.table == nil {
+		return
+	}
+
+	x.enum.table.mu.RLock()
+	defer x.enum.table.mu.RUnlock()
+
+	return x.enum.table.attrs[x.ordinal]
+}
+
+// Ordinal returns the position of x in the declaration order of its enum, starting at 0.
+func (x Member[_, _]) Ordinal() int {
+	return x.ordinal
+}
+
+// Next returns the member declared after x.
+// Returns a not-ok Value if x is the last member.
+func (x Member[T, A]) Next() optional.Value[Member[T, A]] {
+	return x.at(x.ordinal + 1)
+}
+
+// Prev returns the member declared before x.
+// Returns a not-ok Value if x is the first member.
+func (x Member[T, A]) Prev() optional.Value[Member[T, A]] {
+	return x.at(x.ordinal - 1)
+}
+
+// Compare returns -1, 0 or +1 depending on whether x is declared before, at or after other.
+// It orders members by ordinal for sorting, as in:
+//
+//	sort.Slice(members, func(i, j int) bool { return members[i].Compare(members[j]) < 0 })
+func (x Member[T, A]) Compare(other Member[T, A]) int {
+	switch {
+	case x.ordinal < other.ordinal:
+		return -1
+	case x.ordinal > other.ordinal:
+		return 1
+	}
+
+	return 0
+}
+
+// at returns the member of the enum of x with ordinal i.
+func (x Member[T, A]) at(i int) optional.Value[Member[T, A]] {
+	if x.enum.table == nil {
+		return optional.OfNotOk[Member[T, A]]()
+	}
+
+	x.enum.table.mu.RLock()
+	defer x.enum.table.mu.RUnlock()
+
+	if i < 0 || i >= len(x.enum.table.members) {
+		return optional.OfNotOk[Member[T, A]]()
+	}
+
+	return optional.OfOk(x.enum.table.ordinal(i))
+}
@@ -0,0 +1,133 @@
+package enum
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/binaryphile/valor/optional"
+)
+
+type (
+	// Normalizer maps variant spellings of a name to a common form.
+	Normalizer func(string) string
+)
+
+// separators replaces hyphens and spaces with underscores.
+var separators = strings.NewReplacer("-", "_", " ", "_")
+
+// Exact leaves name unchanged. It is the default Normalizer.
+func Exact(name string) string {
+	return name
+}
+
+// FoldCase normalizes name to lower case.
+func FoldCase(name string) string {
+	return strings.ToLower(name)
+}
+
+// TrimSpace normalizes name without leading and trailing white space.
+func TrimSpace(name string) string {
+	return strings.TrimSpace(name)
+}
+
+// Separators normalizes name so that hyphens, underscores and spaces are equivalent.
+func Separators(name string) string {
+	return separators.Replace(name)
+}
+
+// Normalize returns a Normalizer that applies normalizers in order.
+func Normalize(normalizers ...Normalizer) Normalizer {
+	return func(name string) string {
+		for _, normalize := range normalizers {
+			name = normalize(name)
+		}
+
+		return name
+	}
+}
+
+// SetNormalizer sets the Normalizer that Parse applies to names and aliases before comparing them.
+// Member always compares names exactly.
+// A nil normalize means Exact.
+func (x Enum[_, _]) SetNormalizer(normalize Normalizer) {
+	if x.table == nil {
+		return
+	}
+
+	if normalize == nil {
+		normalize = Exact
+	}
+
+	mustChange(x.table.change(func() error {
+		x.table.normalize = normalize
+
+		return nil
+	}))
+}
+
+// Alias adds aliases that Parse accepts as names of member, such as legacy codes.
+// The member's Name is unchanged.
+// It is an error if member is not of x or if an alias is the name or alias of another member.
+func (x Enum[T, A]) Alias(member Member[T, A], aliases ...string) error {
+	if !x.has(member) {
+		return fmt.Errorf("%q: %w", member.value, ErrNotMember)
+	}
+
+	return x.table.change(func() error {
+		for _, name := range aliases {
+			if other := x.table.find(name); other.IsOk() && other.MustOk() != member {
+				return fmt.Errorf("alias %q of %q is already a name of %q", name, member.value, other.MustOk().value)
+			}
+		}
+
+		for _, name := range aliases {
+			x.table.aliases = append(x.table.aliases, alias{name: name, ordinal: member.ordinal})
+		}
+
+		return nil
+	})
+}
+
+// lookup returns the member whose name matches name, either exactly or once both are normalized.
+func (x Enum[T, A]) lookup(name string) optional.Value[Member[T, A]] {
+	if x.table == nil {
+		return optional.OfNotOk[Member[T, A]]()
+	}
+
+	x.table.mu.RLock()
+	defer x.table.mu.RUnlock()
+
+	return x.table.find(name)
+}
+
+// find returns the member whose name matches name exactly
+// or whose normalized name or alias matches the normalized name.
+// x must be locked.
+func (x *table[T, A]) find(name string) optional.Value[Member[T, A]] {
+	if i, ok := x.index[name]; ok {
+		return optional.OfOk(x.ordinal(i))
+	}
+
+	name = x.normalize(name)
+
+	for _, member := range x.members {
+		if x.normalize(string(member.value)) == name {
+			return optional.OfOk(member)
+		}
+	}
+
+	for _, alias := range x.aliases {
+		if x.normalize(alias.name) == name {
+			return optional.OfOk(x.ordinal(alias.ordinal))
+		}
+	}
+
+	return optional.OfNotOk[Member[T, A]]()
+}
+
+// mustChange panics if err, from changing an Enum, is not nil.
+func mustChange(err error) {
+	if err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,79 @@
+package enum_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/binaryphile/valor/enum"
+	"github.com/binaryphile/valor/optional"
+)
+
+type suit string
+
+func TestEnum_Parse_normalized(t *testing.T) {
+	suits, _ := enum.Of[suit, struct{}]("hearts", "spades", "big_joker")
+	suits.SetNormalizer(enum.Normalize(enum.TrimSpace, enum.FoldCase, enum.Separators))
+	hearts := suits.Member("hearts").MustOk()
+	joker := suits.Member("big_joker").MustOk()
+
+	tests := []struct {
+		name string
+		want enum.Member[suit, struct{}]
+	}{
+		{"hearts", hearts},
+		{"HEARTS", hearts},
+		{" Hearts\n", hearts},
+		{"big-joker", joker},
+		{"Big Joker", joker},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := suits.Parse(tt.name)
+			if got.IsError() || got.Value().MustOk() != tt.want {
+				t.Errorf("Parse() = %v, want %v", got, tt.want)
+			}
+			if got.Value().MustOk().Name() != tt.want.Name() {
+				t.Errorf("Name() = %v, want %v", got.Value().MustOk().Name(), tt.want.Name())
+			}
+		})
+	}
+
+	if got := suits.Member("HEARTS"); got.IsOk() {
+		t.Errorf("Member() = %v, want not ok", got)
+	}
+	if got := suits.Parse("clubs"); !got.ErrorIs(enum.ErrNotMember) {
+		t.Errorf("Parse() = %v, want %v", got, enum.ErrNotMember)
+	}
+}
+
+func TestEnum_Alias(t *testing.T) {
+	suits, _ := enum.Of[suit, struct{}]("hearts", "spades")
+	suits.SetNormalizer(enum.FoldCase)
+	hearts := suits.Member("hearts").MustOk()
+
+	if err := suits.Alias(hearts, "H", "coeur"); err != nil {
+		t.Fatalf("Alias() error = %v", err)
+	}
+	for _, name := range []string{"H", "h", "COEUR"} {
+		if got := suits.Parse(name).Value(); got != optional.OfOk(hearts) {
+			t.Errorf("Parse(%q) = %v, want %v", name, got, hearts)
+		}
+	}
+
+	var got enum.Member[suit, struct{}]
+	got = suits.Member("spades").MustOk()
+	if err := json.Unmarshal([]byte(`"h"`), &got); err != nil || got != hearts {
+		t.Errorf("UnmarshalJSON() = %v %v, want %v", got, err, hearts)
+	}
+
+	if err := suits.Alias(hearts, "Spades"); err == nil {
+		t.Errorf("Alias() of another member's name error = %v, want error", err)
+	}
+	if err := suits.Alias(suits.Member("spades").MustOk(), "H"); err == nil {
+		t.Errorf("Alias() of another member's alias error = %v, want error", err)
+	}
+	others, _ := enum.Of[suit, struct{}]("hearts")
+	if err := suits.Alias(others.Member("hearts").MustOk(), "x"); err == nil {
+		t.Errorf("Alias() of another enum's member error = %v, want error", err)
+	}
+}
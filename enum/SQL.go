@@ -0,0 +1,37 @@
+package enum
+
+import (
+	"database/sql/driver"
+	"fmt"
+
+	"github.com/binaryphile/valor/internal/binding"
+)
+
+// Value encodes x as its name for use as a query argument.
+// The zero Member encodes as NULL.
+func (x Member[_, _]) Value() (driver.Value, error) {
+	if x.enum.table == nil {
+		return nil, nil
+	}
+
+	return string(x.value), nil
+}
+
+// Scan decodes a name from a text column into x.
+// The name is looked up as for UnmarshalJSON,
+// so a name that is not in the enum is an error.
+// NULL decodes as the zero Member.
+func (x *Member[T, A]) Scan(src any) error {
+	switch src := src.(type) {
+	case nil:
+		*x = Member[T, A]{}
+
+		return nil
+	case string:
+		return x.parse(src)
+	case []byte:
+		return x.parse(string(src))
+	}
+
+	return fmt.Errorf("cannot scan %T into %v", src, binding.TypeOf[Member[T, A]]())
+}
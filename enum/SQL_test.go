@@ -0,0 +1,59 @@
+package enum_test
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"testing"
+
+	"github.com/binaryphile/valor/enum"
+)
+
+// type checks
+var (
+	_ driver.Valuer = enum.Member[status, statusInfo]{}
+	_ sql.Scanner   = &enum.Member[status, statusInfo]{}
+)
+
+func TestMember_Value(t *testing.T) {
+	if got, err := Statuses.Member(string(Active)).MustOk().Value(); got != "active" || err != nil {
+		t.Errorf("Value() = %v %v, want %v %v", got, err, "active", nil)
+	}
+	if got, err := (enum.Member[status, statusInfo]{}).Value(); got != nil || err != nil {
+		t.Errorf("Value() = %v %v, want %v %v", got, err, nil, nil)
+	}
+}
+
+func TestMember_Scan(t *testing.T) {
+	active := Statuses.Member(string(Active)).MustOk()
+
+	tests := []struct {
+		name    string
+		src     any
+		want    enum.Member[status, statusInfo]
+		wantErr bool
+	}{
+		{"string", "active", active, false},
+		{"bytes", []byte("active"), active, false},
+		{"null", nil, enum.Member[status, statusInfo]{}, false},
+		{"renamed", "enabled", enum.Member[status, statusInfo]{}, true},
+		{"wrong type", int64(1), enum.Member[status, statusInfo]{}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got enum.Member[status, statusInfo]
+			err := got.Scan(tt.src)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Scan() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("Scan() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	var got enum.Member[status, statusInfo]
+	if err := got.Scan("enabled"); !errors.Is(err, enum.ErrNotMember) {
+		t.Errorf("Scan() error = %v, want %v", err, enum.ErrNotMember)
+	}
+}
@@ -0,0 +1,118 @@
+package enum_test
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"testing"
+
+	"github.com/binaryphile/valor/enum"
+)
+
+// TestOf_concurrent registers members while reading the enum from other goroutines.
+// Run with -race to detect unguarded access.
+func TestOf_concurrent(t *testing.T) {
+	type word string
+	words, generate := enum.Of[word, int]("a")
+	words.SetNormalizer(enum.FoldCase)
+
+	const n = 50
+	var wg sync.WaitGroup
+	for i := 0; i < n; i++ {
+		wg.Add(2)
+		go func(i int) {
+			defer wg.Done()
+			member := generate(word(fmt.Sprint("w", i)))
+			_ = words.Alias(member, fmt.Sprint("alias", i))
+			_ = words.Allow(member, words.Member("a").MustOk())
+		}(i)
+		go func(i int) {
+			defer wg.Done()
+			_ = words.Names()
+			_ = words.Parse(fmt.Sprint("ALIAS", i))
+			_ = enum.SetOf(words, words.Members()...).Complement()
+			for _, member := range words.Members() {
+				_ = member.Attr()
+				_ = member.Next()
+				_ = member.Transitions()
+				_ = member.CanTransitionTo(member)
+			}
+		}(i)
+	}
+	wg.Wait()
+
+	if got := len(words.Members()); got != n+1 {
+		t.Errorf("len(Members()) = %v, want %v", got, n+1)
+	}
+	for i, member := range words.Members() {
+		if member.Ordinal() != i {
+			t.Errorf("Ordinal() = %v, want %v", member.Ordinal(), i)
+		}
+	}
+}
+
+func TestEnum_Seal(t *testing.T) {
+	type word string
+	words, generate := enum.Of[word, struct{}]("a", "b")
+	a := words.Member("a").MustOk()
+	b := generate("b")
+
+	if words.Sealed() {
+		t.Errorf("Sealed() = %v, want %v", true, false)
+	}
+	words.Seal()
+	if !words.Sealed() {
+		t.Errorf("Sealed() = %v, want %v", false, true)
+	}
+
+	if err := words.Alias(a, "alpha"); !errors.Is(err, enum.ErrSealed) {
+		t.Errorf("Alias() error = %v, want %v", err, enum.ErrSealed)
+	}
+	if err := words.Allow(a, b); !errors.Is(err, enum.ErrSealed) {
+		t.Errorf("Allow() error = %v, want %v", err, enum.ErrSealed)
+	}
+
+	for name, f := range map[string]func(){
+		"generate":      func() { generate("c") },
+		"SetNormalizer": func() { words.SetNormalizer(enum.FoldCase) },
+		"OnEnter":       func() { words.OnEnter(a, nil) },
+		"OnExit":        func() { words.OnExit(a, nil) },
+	} {
+		t.Run(name, func(t *testing.T) {
+			defer func() {
+				err, _ := recover().(error)
+				if !errors.Is(err, enum.ErrSealed) {
+					t.Errorf("%v() panicked with %v, want %v", name, err, enum.ErrSealed)
+				}
+			}()
+			f()
+		})
+	}
+
+	if got := words.Names(); len(got) != 2 {
+		t.Errorf("Names() after Seal() = %v, want %v", got, []string{"a", "b"})
+	}
+	if got := words.Parse("b"); got.IsError() {
+		t.Errorf("Parse() after Seal() = %v, want %v", got, b)
+	}
+}
+
+func TestIntEnum_Seal(t *testing.T) {
+	opcodes, generate := newOpcodes()
+	opcodes.Seal()
+
+	for name, f := range map[string]func(){
+		"generate":    func() { generate(enum.IntDef[opcode, string]{Value: 9, Name: "x"}) },
+		"EncodeNames": func() { opcodes.EncodeNames(true) },
+	} {
+		t.Run(name, func(t *testing.T) {
+			defer func() {
+				err, _ := recover().(error)
+				if !errors.Is(err, enum.ErrSealed) {
+					t.Errorf("%v() panicked with %v, want %v", name, err, enum.ErrSealed)
+				}
+			}()
+			f()
+		})
+	}
+}
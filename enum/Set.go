@@ -0,0 +1,208 @@
+package enum
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/bits-and-blooms/bitset"
+)
+
+type (
+	// Set is a set of members of an Enum, stored as a bitset indexed by ordinal.
+	// Like Member, a Set is a value: its methods return a new Set rather than modifying it.
+	// A zero Set takes the enum of the first member added to it.
+	Set[T ~string, A any] struct {
+		enum Enum[T, A]
+		bits *bitset.BitSet
+	}
+)
+
+// SetOf creates a Set of members of enum.
+func SetOf[T ~string, A any](enum Enum[T, A], members ...Member[T, A]) Set[T, A] {
+	return Set[T, A]{enum: enum}.Add(members...)
+}
+
+// Add returns a Set of the members of x and members.
+// Members of another enum, including the zero Member, are ignored.
+func (x Set[T, A]) Add(members ...Member[T, A]) Set[T, A] {
+	result := x.clone()
+
+	for _, member := range members {
+		if result.enum.table == nil {
+			result.enum = member.enum
+		}
+
+		if member.enum.table == nil || member.enum != result.enum {
+			continue
+		}
+
+		result.bits.Set(uint(member.ordinal))
+	}
+
+	return result
+}
+
+// Remove returns a Set of the members of x other than members.
+// Members of another enum, including the zero Member, are ignored.
+func (x Set[T, A]) Remove(members ...Member[T, A]) Set[T, A] {
+	result := x.clone()
+
+	for _, member := range members {
+		if member.enum.table == nil || member.enum != result.enum {
+			continue
+		}
+
+		result.bits.Clear(uint(member.ordinal))
+	}
+
+	return result
+}
+
+// Contains returns whether member is in x.
+func (x Set[T, A]) Contains(member Member[T, A]) bool {
+	return x.bits != nil && member.enum == x.enum && x.bits.Test(uint(member.ordinal))
+}
+
+// Len returns the number of members in x.
+func (x Set[_, _]) Len() int {
+	if x.bits == nil {
+		return 0
+	}
+
+	return int(x.bits.Count())
+}
+
+// Equal returns whether x and other contain the same members.
+// Sets of different enums are equal only if both are empty.
+func (x Set[T, A]) Equal(other Set[T, A]) bool {
+	if x.enum != other.enum {
+		return x.Len() == 0 && other.Len() == 0
+	}
+
+	return x.clone().bits.SymmetricDifferenceCardinality(other.clone().bits) == 0
+}
+
+// Union returns a Set of the members in either x or other.
+// other must be of the same enum as x, unless either is the zero Set;
+// otherwise it panics with an error wrapping ErrNotMember.
+func (x Set[T, A]) Union(other Set[T, A]) Set[T, A] {
+	return x.combine(other, (*bitset.BitSet).InPlaceUnion)
+}
+
+// Intersect returns a Set of the members in both x and other.
+// other must be of the same enum as x, unless either is the zero Set;
+// otherwise it panics with an error wrapping ErrNotMember.
+func (x Set[T, A]) Intersect(other Set[T, A]) Set[T, A] {
+	return x.combine(other, (*bitset.BitSet).InPlaceIntersection)
+}
+
+// Difference returns a Set of the members in x but not in other.
+// other must be of the same enum as x, unless either is the zero Set;
+// otherwise it panics with an error wrapping ErrNotMember.
+func (x Set[T, A]) Difference(other Set[T, A]) Set[T, A] {
+	return x.combine(other, (*bitset.BitSet).InPlaceDifference)
+}
+
+// Complement returns a Set of the members of the enum that are not in x.
+func (x Set[T, A]) Complement() Set[T, A] {
+	n := uint(len(x.enum.Members()))
+
+	all := Set[T, A]{
+		enum: x.enum,
+		bits: bitset.New(n).FlipRange(0, n),
+	}
+
+	return all.Difference(x)
+}
+
+// Members returns the members of x in declaration order.
+func (x Set[T, A]) Members() []Member[T, A] {
+	members := make([]Member[T, A], 0, x.Len())
+
+	if x.bits == nil {
+		return members
+	}
+
+	for i, ok := x.bits.NextSet(0); ok; i, ok = x.bits.NextSet(i + 1) {
+		members = append(members, x.enum.ordinal(int(i)))
+	}
+
+	return members
+}
+
+// Names returns the names of the members of x in declaration order.
+func (x Set[_, _]) Names() []string {
+	return namesOf(x.Members())
+}
+
+// String returns x formatted as a string.
+func (x Set[_, _]) String() string {
+	return fmt.Sprintf("{%s}", strings.Join(x.Names(), " "))
+}
+
+// MarshalJSON encodes x as an array of names in declaration order.
+func (x Set[_, _]) MarshalJSON() ([]byte, error) {
+	return json.Marshal(x.Names())
+}
+
+// UnmarshalJSON decodes an array of names into x.
+// It is an error if a name is not in the enum.
+// If x has an enum, the names are looked up in it.
+// Otherwise they are looked up in the Enum bound to the type of x.
+// Does nothing if data is the literal null.
+func (x *Set[T, A]) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		// by convention, null is no-op
+		return nil
+	}
+
+	var names []string
+	if err := json.Unmarshal(data, &names); err != nil {
+		return err
+	}
+
+	result := Set[T, A]{enum: x.enum}
+
+	for _, name := range names {
+		member := Member[T, A]{enum: x.enum}
+		if err := member.parse(name); err != nil {
+			return err
+		}
+
+		result = result.Add(member)
+	}
+
+	*x = result
+
+	return nil
+}
+
+// clone returns a copy of x with its own bitset.
+func (x Set[T, A]) clone() Set[T, A] {
+	if x.bits == nil {
+		x.bits = bitset.New(0)
+	} else {
+		x.bits = x.bits.Clone()
+	}
+
+	return x
+}
+
+// combine returns a copy of x with its bitset combined with that of other by f.
+// It panics if x and other are of different enums.
+func (x Set[T, A]) combine(other Set[T, A], f func(*bitset.BitSet, *bitset.BitSet)) Set[T, A] {
+	if x.enum.table != nil && other.enum.table != nil && x.enum != other.enum {
+		panic(fmt.Errorf("set of another enum: %w", ErrNotMember))
+	}
+
+	result := x.clone()
+
+	if result.enum.table == nil {
+		result.enum = other.enum
+	}
+
+	f(result.bits, other.clone().bits)
+
+	return result
+}
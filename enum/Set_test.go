@@ -0,0 +1,154 @@
+package enum_test
+
+import (
+	"encoding/json"
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/binaryphile/valor/enum"
+)
+
+type permission string
+
+var (
+	Permissions, _ = enum.Of[permission, struct{}]("read", "write", "delete", "admin")
+
+	Read   = Permissions.Member("read").MustOk()
+	Write  = Permissions.Member("write").MustOk()
+	Delete = Permissions.Member("delete").MustOk()
+	Admin  = Permissions.Member("admin").MustOk()
+)
+
+// type checks
+var (
+	_ json.Marshaler   = enum.Set[permission, struct{}]{}
+	_ json.Unmarshaler = &enum.Set[permission, struct{}]{}
+)
+
+func TestSet(t *testing.T) {
+	rw := enum.SetOf(Permissions, Write, Read)
+	if got, want := rw.Names(), []string{"read", "write"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Names() = %v, want %v", got, want)
+	}
+	if !rw.Contains(Read) || rw.Contains(Admin) {
+		t.Errorf("Contains() = %v %v, want %v %v", rw.Contains(Read), rw.Contains(Admin), true, false)
+	}
+	if got := rw.Len(); got != 2 {
+		t.Errorf("Len() = %v, want %v", got, 2)
+	}
+
+	rwd := rw.Add(Delete)
+	if rw.Contains(Delete) {
+		t.Errorf("Add() modified receiver")
+	}
+	if got := rwd.Remove(Read); !got.Equal(enum.SetOf(Permissions, Write, Delete)) {
+		t.Errorf("Remove() = %v, want %v", got, enum.SetOf(Permissions, Write, Delete))
+	}
+	if !rwd.Contains(Read) {
+		t.Errorf("Remove() modified receiver")
+	}
+}
+
+func TestSet_operations(t *testing.T) {
+	rw := enum.SetOf(Permissions, Read, Write)
+	wd := enum.SetOf(Permissions, Write, Delete)
+
+	tests := []struct {
+		name string
+		got  enum.Set[permission, struct{}]
+		want []string
+	}{
+		{"Union", rw.Union(wd), []string{"read", "write", "delete"}},
+		{"Intersect", rw.Intersect(wd), []string{"write"}},
+		{"Difference", rw.Difference(wd), []string{"read"}},
+		{"Complement", rw.Complement(), []string{"delete", "admin"}},
+		{"Complement of empty", enum.SetOf(Permissions).Complement(), []string{"read", "write", "delete", "admin"}},
+		{"zero Union", enum.Set[permission, struct{}]{}.Union(wd), []string{"write", "delete"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.got.Names(); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("%v() = %v, want %v", tt.name, got, tt.want)
+			}
+		})
+	}
+	if got, want := rw.Names(), []string{"read", "write"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("receiver after operations = %v, want %v", got, want)
+	}
+}
+
+func TestSet_zero(t *testing.T) {
+	var zero enum.Set[permission, struct{}]
+	if zero.Contains(Read) || zero.Len() != 0 || len(zero.Members()) != 0 {
+		t.Errorf("zero Set = %v, want empty", zero)
+	}
+	if got := zero.Add(Admin).Complement(); got.Len() != 3 {
+		t.Errorf("Complement() of Add() to zero Set = %v, want 3 members", got)
+	}
+}
+
+func TestSet_foreign(t *testing.T) {
+	others, _ := enum.Of[permission, struct{}]("read", "write")
+	otherRead := others.Member("read").MustOk()
+
+	zeroAdded := enum.Set[permission, struct{}]{}.Add(enum.Member[permission, struct{}]{})
+	if zeroAdded.Len() != 0 || zeroAdded.String() != "{}" {
+		t.Errorf("Add() of zero Member = %v, want empty", zeroAdded)
+	}
+	if _, err := json.Marshal(zeroAdded); err != nil {
+		t.Errorf("MarshalJSON() error = %v", err)
+	}
+
+	rw := enum.SetOf(Permissions, Read, Write)
+	if got := rw.Add(otherRead, enum.Member[permission, struct{}]{}); !got.Equal(rw) {
+		t.Errorf("Add() of foreign members = %v, want %v", got, rw)
+	}
+	if got := rw.Remove(otherRead); !got.Equal(rw) {
+		t.Errorf("Remove() of foreign member = %v, want %v", got, rw)
+	}
+
+	if enum.SetOf(others, otherRead).Equal(enum.SetOf(Permissions, Read)) {
+		t.Errorf("Equal() of sets of different enums = true, want false")
+	}
+	if !enum.SetOf(others).Equal(enum.Set[permission, struct{}]{}) {
+		t.Errorf("Equal() of empty sets = false, want true")
+	}
+
+	defer func() {
+		if err, _ := recover().(error); !errors.Is(err, enum.ErrNotMember) {
+			t.Errorf("Union() of sets of different enums panic = %v, want %v", err, enum.ErrNotMember)
+		}
+	}()
+	rw.Union(enum.SetOf(others, otherRead))
+}
+
+func TestSet_String(t *testing.T) {
+	if got := enum.SetOf(Permissions, Admin, Read).String(); got != "{read admin}" {
+		t.Errorf("String() = %v, want %v", got, "{read admin}")
+	}
+}
+
+func TestSet_JSON(t *testing.T) {
+	var got struct {
+		Grants enum.Set[permission, struct{}] `json:"grants"`
+	}
+	if err := json.Unmarshal([]byte(`{"grants":["admin","read"]}`), &got); err != nil {
+		t.Fatalf("UnmarshalJSON() error = %v", err)
+	}
+	if want := enum.SetOf(Permissions, Read, Admin); !got.Grants.Equal(want) {
+		t.Errorf("UnmarshalJSON() = %v, want %v", got.Grants, want)
+	}
+
+	b, err := json.Marshal(got)
+	if err != nil {
+		t.Fatalf("MarshalJSON() error = %v", err)
+	}
+	if string(b) != `{"grants":["read","admin"]}` {
+		t.Errorf("MarshalJSON() = %s, want %s", b, `{"grants":["read","admin"]}`)
+	}
+
+	if err := json.Unmarshal([]byte(`{"grants":["root"]}`), &got); !errors.Is(err, enum.ErrNotMember) {
+		t.Errorf("UnmarshalJSON() error = %v, want %v", err, enum.ErrNotMember)
+	}
+}
@@ -1,6 +1,6 @@
 module github.com/binaryphile/valor
 
-go 1.19
+go 1.20
 
 require (
 	github.com/bits-and-blooms/bitset v1.5.0
@@ -0,0 +1,44 @@
+// Copyright 2022 phelmkamp. All rights reserved.
+// Use of this source code is governed by a MIT
+// license that can be found in the LICENSE file.
+
+// Package binding records the value used to decode zero values of a type,
+// such as the Enum of a zero Member.
+// Values are only bound when asked, so unrelated values of the same type never conflict.
+package binding
+
+import (
+	"reflect"
+	"sync"
+
+	"github.com/binaryphile/valor/optional"
+)
+
+var (
+	mu     sync.RWMutex
+	values = make(map[reflect.Type]any)
+)
+
+// Bind makes v the value bound to type K, replacing any bound before.
+func Bind[K, V any](v V) {
+	mu.Lock()
+	defer mu.Unlock()
+
+	values[TypeOf[K]()] = v
+}
+
+// Bound returns an optional.Value of the value bound to type K.
+// Returns a not-ok Value if none is.
+func Bound[K, V any]() optional.Value[V] {
+	mu.RLock()
+	defer mu.RUnlock()
+
+	v, ok := values[TypeOf[K]()].(V)
+
+	return optional.Of(v, ok)
+}
+
+// TypeOf returns the reflect.Type of K.
+func TypeOf[K any]() reflect.Type {
+	return reflect.TypeOf((*K)(nil)).Elem()
+}
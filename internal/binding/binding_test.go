@@ -0,0 +1,29 @@
+// Copyright 2022 phelmkamp. All rights reserved.
+// Use of this source code is governed by a MIT
+// license that can be found in the LICENSE file.
+
+package binding_test
+
+import (
+	"testing"
+
+	"github.com/binaryphile/valor/internal/binding"
+)
+
+func TestBind(t *testing.T) {
+	type key struct{}
+
+	if got := binding.Bound[key, string](); got.IsOk() {
+		t.Errorf("Bound() = %v, want not ok", got)
+	}
+
+	binding.Bind[key]("first")
+	binding.Bind[key]("second")
+	if got := binding.Bound[key, string](); got.MustOk() != "second" {
+		t.Errorf("Bound() = %v, want %v", got, "second")
+	}
+
+	if got := binding.Bound[key, int](); got.IsOk() {
+		t.Errorf("Bound() of another value type = %v, want not ok", got)
+	}
+}
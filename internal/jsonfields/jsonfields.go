@@ -0,0 +1,132 @@
+// Copyright 2022 phelmkamp. All rights reserved.
+// Use of this source code is governed by a MIT
+// license that can be found in the LICENSE file.
+
+// Package jsonfields lists the fields of struct types as encoding/json encodes them.
+package jsonfields
+
+import (
+	"go/token"
+	"reflect"
+	"strings"
+)
+
+// Field is a field of a struct as encoding/json encodes it.
+type Field struct {
+	Name      string
+	Type      reflect.Type
+	OmitEmpty bool
+	depth     int
+	tagged    bool
+}
+
+// Of returns the fields of the struct type t in the order encoding/json encodes them,
+// with the fields of embedded structs promoted.
+// As with encoding/json, of fields with the same name, the least embedded one is kept,
+// or the tagged one if several are equally embedded;
+// if that leaves more than one, none are kept.
+// So each name is that of one field at most.
+func Of(t reflect.Type) []Field {
+	candidates := collect(nil, t, 0, map[reflect.Type]bool{})
+
+	byName := make(map[string][]Field)
+	for _, field := range candidates {
+		byName[field.Name] = append(byName[field.Name], field)
+	}
+
+	fields := make([]Field, 0, len(candidates))
+
+	for _, field := range candidates {
+		if dominant, ok := dominantField(byName[field.Name]); ok && dominant == field {
+			fields = append(fields, field)
+		}
+	}
+
+	return fields
+}
+
+// collect appends the fields of the struct type t, embedded depth levels deep, to fields,
+// including those of embedded structs, which may be embedded by pointer.
+// seen has the struct types embedded so far, to stop cycles of embedded pointers.
+func collect(fields []Field, t reflect.Type, depth int, seen map[reflect.Type]bool) []Field {
+	seen[t] = true
+	defer delete(seen, t)
+
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+
+		tag := field.Tag.Get("json")
+		if tag == "-" {
+			continue
+		}
+
+		name, opts, _ := strings.Cut(tag, ",")
+
+		if field.Anonymous && name == "" {
+			embedded := field.Type
+			if embedded.Kind() == reflect.Pointer {
+				embedded = embedded.Elem()
+			}
+
+			if embedded.Kind() == reflect.Struct {
+				// encoding/json ignores embedded pointers to unexported struct types
+				if !seen[embedded] && (field.Type.Kind() != reflect.Pointer || embedded.Name() == "" || token.IsExported(embedded.Name())) {
+					fields = collect(fields, embedded, depth+1, seen)
+				}
+
+				continue
+			}
+		}
+
+		if !field.IsExported() {
+			continue
+		}
+
+		if name == "" {
+			name = field.Name
+		}
+
+		fields = append(fields, Field{
+			Name:      name,
+			Type:      field.Type,
+			OmitEmpty: strings.Contains(","+opts+",", ",omitempty,"),
+			depth:     depth,
+			tagged:    tag != "" && !strings.HasPrefix(tag, ","),
+		})
+	}
+
+	return fields
+}
+
+// dominantField returns the field encoding/json keeps of fields, which have the same name.
+func dominantField(fields []Field) (Field, bool) {
+	depth := fields[0].depth
+	for _, field := range fields {
+		if field.depth < depth {
+			depth = field.depth
+		}
+	}
+
+	var shallowest, tagged []Field
+
+	for _, field := range fields {
+		if field.depth != depth {
+			continue
+		}
+
+		shallowest = append(shallowest, field)
+
+		if field.tagged {
+			tagged = append(tagged, field)
+		}
+	}
+
+	switch {
+	case len(shallowest) == 1:
+		return shallowest[0], true
+	case len(tagged) == 1:
+		return tagged[0], true
+	}
+
+	return Field{}, false
+}
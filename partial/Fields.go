@@ -0,0 +1,132 @@
+package partial
+
+import (
+	"encoding"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+
+	"github.com/bits-and-blooms/bitset"
+
+	"github.com/binaryphile/valor/internal/jsonfields"
+)
+
+// ErrUnknownField is the error for a name that is not one of the field names of a Partial.
+var ErrUnknownField = errors.New("not a field")
+
+var (
+	// fieldNamesCache has the field names of each type given to For.
+	fieldNamesCache sync.Map
+
+	gjsonEscaper = strings.NewReplacer(`\`, `\\`, ".", `\.`, "*", `\*`, "?", `\?`, "|", `\|`, "#", `\#`, "@", `\@`)
+
+	jsonMarshalerType = reflect.TypeOf((*json.Marshaler)(nil)).Elem()
+	textMarshalerType = reflect.TypeOf((*encoding.TextMarshaler)(nil)).Elem()
+)
+
+// For creates a Partial of the zero T with no active fields.
+// Its FieldNames are derived from the json tags of T, in the gjson syntax MarshalJSON expects,
+// following the rules of encoding/json for names, omitted fields and embedded structs.
+// The fields of a nested struct are named by path, such as "address.city", rather than by the struct itself,
+// unless it encodes itself, as does time.Time.
+// The names are derived once per type; each Partial gets its own copy.
+func For[T any]() Partial[T] {
+	var value T
+
+	// copy the cached names so that changes to them do not reach later Partials
+	fieldNames := append([]string(nil), fieldNamesOf(reflect.TypeOf((*T)(nil)).Elem())...)
+
+	return NewPartial(value, bitset.New(uint(len(fieldNames))), fieldNames)
+}
+
+// Set activates the fields named names.
+// It is an error if a name is not one of the FieldNames of x; then no field is activated.
+func (x *Partial[T]) Set(names ...string) error {
+	indexes := make([]uint, len(names))
+
+	for i, name := range names {
+		index, err := x.index(name)
+		if err != nil {
+			return err
+		}
+
+		indexes[i] = index
+	}
+
+	if x.FieldMask == nil {
+		x.FieldMask = bitset.New(uint(len(x.FieldNames)))
+	}
+
+	for _, index := range indexes {
+		x.FieldMask.Set(index)
+	}
+
+	return nil
+}
+
+// Has returns whether the field named name is active.
+// It is an error if name is not one of the FieldNames of x.
+func (x Partial[T]) Has(name string) (bool, error) {
+	index, err := x.index(name)
+	if err != nil {
+		return false, err
+	}
+
+	return x.FieldMask != nil && x.FieldMask.Test(index), nil
+}
+
+// index returns the index of the field named name.
+func (x Partial[T]) index(name string) (uint, error) {
+	for i, fieldName := range x.FieldNames {
+		if fieldName == name {
+			return uint(i), nil
+		}
+	}
+
+	return 0, fmt.Errorf("%q: %w", name, ErrUnknownField)
+}
+
+// fieldNamesOf returns the field names of the type t, caching them.
+func fieldNamesOf(t reflect.Type) []string {
+	if fieldNames, ok := fieldNamesCache.Load(t); ok {
+		return fieldNames.([]string)
+	}
+
+	fieldNames := make([]string, 0)
+
+	if t.Kind() == reflect.Struct {
+		fieldNames = appendFieldNames(fieldNames, "", t)
+	}
+
+	fieldNamesCache.Store(t, fieldNames)
+
+	return fieldNames
+}
+
+// appendFieldNames appends the names of the fields of the struct type t, prefixed by prefix, to fieldNames.
+func appendFieldNames(fieldNames []string, prefix string, t reflect.Type) []string {
+	for _, field := range jsonfields.Of(t) {
+		path := prefix + gjsonEscaper.Replace(field.Name)
+
+		if expands(field.Type) {
+			fieldNames = appendFieldNames(fieldNames, path+".", field.Type)
+
+			continue
+		}
+
+		fieldNames = append(fieldNames, path)
+	}
+
+	return fieldNames
+}
+
+// expands returns whether t is a struct whose fields are named individually
+// rather than a type that encodes itself.
+func expands(t reflect.Type) bool {
+	return t.Kind() == reflect.Struct &&
+		!t.Implements(jsonMarshalerType) && !reflect.PointerTo(t).Implements(jsonMarshalerType) &&
+		!t.Implements(textMarshalerType) && !reflect.PointerTo(t).Implements(textMarshalerType)
+}
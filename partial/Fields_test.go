@@ -0,0 +1,189 @@
+package partial_test
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/binaryphile/valor/optional"
+	"github.com/binaryphile/valor/partial"
+)
+
+type (
+	audit struct {
+		Created time.Time `json:"created"`
+	}
+
+	account struct {
+		audit
+		ID      int                    `json:"id"`
+		Email   string                 `json:"email,omitempty"`
+		Address address                `json:"address"`
+		Nick    optional.Value[string] `json:"nick"`
+		Dotted  string                 `json:"a.b"`
+		Parent  *account               `json:"parent"`
+		Secret  string                 `json:"-"`
+		Plain   bool
+		hidden  int
+	}
+)
+
+func TestFor(t *testing.T) {
+	got := partial.For[account]()
+
+	want := []string{"created", "id", "email", "address.city", "nick", `a\.b`, "parent", "Plain"}
+	if !reflect.DeepEqual(got.FieldNames, want) {
+		t.Errorf("For() FieldNames = %v, want %v", got.FieldNames, want)
+	}
+	if got.FieldMask.Any() {
+		t.Errorf("For() FieldMask = %v, want none", got.FieldMask)
+	}
+	if got.Value != (account{}) {
+		t.Errorf("For() Value = %+v, want zero", got.Value)
+	}
+
+	got.FieldNames[0] = "changed"
+	if again := partial.For[account](); !reflect.DeepEqual(again.FieldNames, want) {
+		t.Errorf("For() after changing FieldNames = %v, want %v", again.FieldNames, want)
+	}
+	if got := partial.For[int]().FieldNames; len(got) != 0 {
+		t.Errorf("For[int]() FieldNames = %v, want none", got)
+	}
+}
+
+func TestPartial_Set(t *testing.T) {
+	p := partial.For[account]()
+	p.Value.Email = "ann@example.com"
+	p.Value.Address.City = "Oslo"
+	p.Value.Dotted = "x"
+
+	if err := p.Set("email", "address.city", `a\.b`); err != nil {
+		t.Fatalf("Set() error = %v", err)
+	}
+
+	for _, tt := range []struct {
+		name string
+		want bool
+	}{
+		{"email", true},
+		{"address.city", true},
+		{`a\.b`, true},
+		{"id", false},
+	} {
+		if got, err := p.Has(tt.name); err != nil || got != tt.want {
+			t.Errorf("Has(%q) = %v %v, want %v", tt.name, got, err, tt.want)
+		}
+	}
+
+	want := `[{"op":"replace","path":"/email","value":"ann@example.com"},` +
+		`{"op":"replace","path":"/address/city","value":"Oslo"},` +
+		`{"op":"replace","path":"/a.b","value":"x"}]`
+	if got := string(p.MustMarshalJSON()); got != want {
+		t.Errorf("MarshalJSON() = %v, want %v", got, want)
+	}
+}
+
+func TestPartial_Set_unknown(t *testing.T) {
+	p := partial.For[account]()
+
+	if err := p.Set("id", "password"); !errors.Is(err, partial.ErrUnknownField) {
+		t.Errorf("Set() error = %v, want %v", err, partial.ErrUnknownField)
+	}
+	if got, _ := p.Has("id"); got {
+		t.Errorf("Set() with unknown name activated %q", "id")
+	}
+	if _, err := p.Has("address"); !errors.Is(err, partial.ErrUnknownField) {
+		t.Errorf("Has() error = %v, want %v", err, partial.ErrUnknownField)
+	}
+
+	var zero partial.Partial[account]
+	if err := zero.Set("id"); !errors.Is(err, partial.ErrUnknownField) {
+		t.Errorf("Set() on zero Partial error = %v, want %v", err, partial.ErrUnknownField)
+	}
+}
+
+func TestFor_FromPatch(t *testing.T) {
+	p := partial.For[account]()
+
+	got, err := partial.FromPatch(p.Value, p.FieldNames, []byte(`[{"op":"replace","path":"/address/city","value":"Rome"}]`)).Unpack()
+	if err != nil {
+		t.Fatalf("FromPatch() error = %v", err)
+	}
+	if has, _ := got.Has("address.city"); !has || got.Value.Address.City != "Rome" {
+		t.Errorf("FromPatch() = %+v, want address.city Rome", got)
+	}
+}
+
+func TestFor_embedded(t *testing.T) {
+	type (
+		Meta struct {
+			Owner string `json:"owner"`
+			Title string `json:"title"`
+			Note  string
+		}
+
+		Stamp struct {
+			Note    string
+			Version int `json:"version"`
+		}
+
+		Loose struct {
+			Version int
+		}
+
+		Tagged struct {
+			V int `json:"Version"`
+		}
+
+		doc struct {
+			*Meta
+			Stamp
+			Title string `json:"title"`
+		}
+
+		versioned struct {
+			Loose
+			Tagged
+			Plain struct {
+				Version int
+			}
+		}
+	)
+
+	tests := []struct {
+		name string
+		got  []string
+		want []string
+	}{
+		// doc.Title hides Meta.Title; Meta.Note and Stamp.Note conflict, so neither is encoded
+		{"pointer", partial.For[doc]().FieldNames, []string{"owner", "version", "title"}},
+		// Tagged.V is tagged, so it hides Loose.Version
+		{"conflict", partial.For[versioned]().FieldNames, []string{"Version", "Plain.Version"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if !reflect.DeepEqual(tt.got, tt.want) {
+				t.Errorf("For() FieldNames = %v, want %v", tt.got, tt.want)
+			}
+		})
+	}
+
+	type page struct {
+		*Meta
+		Body string `json:"body"`
+	}
+
+	p := partial.For[page]()
+	if want := []string{"owner", "title", "Note", "body"}; !reflect.DeepEqual(p.FieldNames, want) {
+		t.Fatalf("For() FieldNames = %v, want %v", p.FieldNames, want)
+	}
+
+	p.Value.Meta = &Meta{Owner: "ann"}
+	if err := p.Set("owner"); err != nil {
+		t.Fatalf("Set() error = %v", err)
+	}
+	if got, want := string(p.MustMarshalJSON()), `[{"op":"replace","path":"/owner","value":"ann"}]`; got != want {
+		t.Errorf("MarshalJSON() = %v, want %v", got, want)
+	}
+}
@@ -3,11 +3,22 @@ package partial
 import (
 	"encoding/json"
 	"errors"
+	"strings"
+
 	"github.com/bits-and-blooms/bitset"
 	"github.com/tidwall/gjson"
 )
 
+var pointerEscaper = strings.NewReplacer("~", "~0", "/", "~1")
+
 type (
+	// operation is an operation of a JSON Patch document.
+	operation struct {
+		Op    string          `json:"op"`
+		Path  string          `json:"path"`
+		Value json.RawMessage `json:"value,omitempty"`
+	}
+
 	Partial[T any] struct {
 		FieldMask  *bitset.BitSet
 		FieldNames []string
@@ -23,6 +34,12 @@ func NewPartial[T any](value T, fieldMask *bitset.BitSet, fieldNames []string) P
 	}
 }
 
+// MarshalJSON encodes the active fields of x as a JSON Patch (RFC 6902) document.
+// Each active field is an operation that replaces the value at its path,
+// converted from gjson syntax to a JSON Pointer,
+// or adds null there if the field is absent from the encoding of Value, such as an empty field tagged omitempty.
+// Unlike replace or remove, add does not require the target document to have the member,
+// which it may well lack when the field is absent there too.
 func (x Partial[T]) MarshalJSON() (_ []byte, err error) {
 	paths := x.activePaths()
 
@@ -36,24 +53,25 @@ func (x Partial[T]) MarshalJSON() (_ []byte, err error) {
 		return nil, errors.New("parsing error")
 	}
 
-	patch := make([]byte, 0)
+	patch := make([]operation, 0, len(paths))
 
 	for i, result := range results {
-		op := map[string]any{
-			"op":    "replace",
-			"path":  paths[i],
-			"value": result.Value(),
+		op := operation{
+			Op:   "replace",
+			Path: pointer(paths[i]),
 		}
 
-		byteJSON, err = json.Marshal(op)
-		if err != nil {
-			return
+		if result.Exists() {
+			op.Value = json.RawMessage(result.Raw)
+		} else {
+			op.Op = "add"
+			op.Value = json.RawMessage("null")
 		}
 
-		patch = append(patch, byteJSON...)
+		patch = append(patch, op)
 	}
 
-	return patch, nil
+	return json.Marshal(patch)
 }
 
 func (x Partial[T]) MustMarshalJSON() []byte {
@@ -76,3 +94,30 @@ func (x Partial[T]) activePaths() []string {
 
 	return paths
 }
+
+// pointer converts the gjson path to a JSON Pointer (RFC 6901).
+// The path's components are separated by unescaped dots, and
+// each is escaped with ~0 for ~ and ~1 for /.
+func pointer(path string) string {
+	var b strings.Builder
+
+	component := make([]byte, 0, len(path))
+
+	for i := 0; i <= len(path); i++ {
+		switch {
+		case i == len(path) || path[i] == '.':
+			b.WriteByte('/')
+			b.WriteString(pointerEscaper.Replace(string(component)))
+
+			component = component[:0]
+		case path[i] == '\\' && i+1 < len(path):
+			i++
+
+			component = append(component, path[i])
+		default:
+			component = append(component, path[i])
+		}
+	}
+
+	return b.String()
+}
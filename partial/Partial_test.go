@@ -0,0 +1,131 @@
+package partial_test
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/bits-and-blooms/bitset"
+
+	"github.com/binaryphile/valor/partial"
+)
+
+type (
+	address struct {
+		City string `json:"city"`
+	}
+
+	user struct {
+		Name    string            `json:"name"`
+		Email   string            `json:"email,omitempty"`
+		Address address           `json:"address"`
+		Labels  map[string]string `json:"labels"`
+	}
+)
+
+var userFields = []string{"name", "email", "address.city", `labels.a/b`, `labels.x~y`, `labels.dotted\.key`}
+
+func TestPartial_MarshalJSON(t *testing.T) {
+	value := user{
+		Name:    "ann",
+		Address: address{City: "Oslo"},
+		Labels:  map[string]string{"a/b": "slash", "x~y": "tilde", "dotted.key": "dot"},
+	}
+
+	tests := []struct {
+		name string
+		mask []uint
+		want string
+	}{
+		{"none", nil, `[]`},
+		{"top-level", []uint{0}, `[{"op":"replace","path":"/name","value":"ann"}]`},
+		{"nested", []uint{2}, `[{"op":"replace","path":"/address/city","value":"Oslo"}]`},
+		{"absent", []uint{1}, `[{"op":"add","path":"/email","value":null}]`},
+		{
+			"escaped", []uint{3, 4, 5},
+			`[{"op":"replace","path":"/labels/a~1b","value":"slash"},` +
+				`{"op":"replace","path":"/labels/x~0y","value":"tilde"},` +
+				`{"op":"replace","path":"/labels/dotted.key","value":"dot"}]`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mask := bitset.New(uint(len(userFields)))
+			for _, i := range tt.mask {
+				mask.Set(i)
+			}
+
+			got, err := partial.NewPartial(value, mask, userFields).MarshalJSON()
+			if err != nil {
+				t.Fatalf("MarshalJSON() error = %v", err)
+			}
+			if string(got) != tt.want {
+				t.Errorf("MarshalJSON() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+// TestPartial_MarshalJSON_absent applies the patch of an absent field to a target that lacks the member,
+// which RFC 6902 requires of replace and remove but not of add.
+func TestPartial_MarshalJSON_absent(t *testing.T) {
+	mask := bitset.New(uint(len(userFields))).Set(1)
+	patch := partial.NewPartial(user{Name: "bo"}, mask, userFields).MustMarshalJSON()
+
+	target := user{Name: "ann"}
+	got, err := partial.FromPatch(target, userFields, patch).Unpack()
+	if err != nil {
+		t.Fatalf("FromPatch(%s) error = %v", patch, err)
+	}
+	if got.Value.Email != "" || !got.FieldMask.Test(1) {
+		t.Errorf("FromPatch(%s) = %+v, want empty email in mask", patch, got)
+	}
+
+	target.Email = "ann@example.com"
+	if got := partial.FromPatch(target, userFields, patch).Value().MustOk(); got.Value.Email != "" {
+		t.Errorf("FromPatch(%s) of target with the member = %+v, want empty email", patch, got.Value)
+	}
+}
+
+func TestPartial_MarshalJSON_roundTrip(t *testing.T) {
+	mask := bitset.New(uint(len(userFields))).Set(0).Set(2)
+	update := struct {
+		Patch partial.Partial[user] `json:"patch"`
+	}{
+		Patch: partial.NewPartial(user{Name: "bo", Address: address{City: "Rome"}}, mask, userFields),
+	}
+
+	data, err := json.Marshal(update)
+	if err != nil {
+		t.Fatalf("Marshal() error = %v", err)
+	}
+
+	type operation struct {
+		Op    string `json:"op"`
+		Path  string `json:"path"`
+		Value any    `json:"value"`
+	}
+
+	var got struct {
+		Patch []operation `json:"patch"`
+	}
+	if err := json.Unmarshal(data, &got); err != nil {
+		t.Fatalf("Unmarshal(%s) error = %v", data, err)
+	}
+
+	want := []operation{
+		{Op: "replace", Path: "/name", Value: "bo"},
+		{Op: "replace", Path: "/address/city", Value: "Rome"},
+	}
+	if !reflect.DeepEqual(got.Patch, want) {
+		t.Errorf("Unmarshal(Marshal()) = %+v, want %+v", got.Patch, want)
+	}
+}
+
+func TestPartial_MustMarshalJSON(t *testing.T) {
+	mask := bitset.New(1).Set(0)
+
+	if got, want := string(partial.NewPartial(user{Name: "cy"}, mask, userFields).MustMarshalJSON()), `[{"op":"replace","path":"/name","value":"cy"}]`; got != want {
+		t.Errorf("MustMarshalJSON() = %v, want %v", got, want)
+	}
+}
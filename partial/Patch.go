@@ -0,0 +1,308 @@
+package partial
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/bits-and-blooms/bitset"
+
+	"github.com/binaryphile/valor/result"
+)
+
+var (
+	// ErrUnknownPath is the error for a patch path that is not one of the field names of a Partial.
+	ErrUnknownPath = errors.New("path is not a field")
+
+	// ErrTestFailed is the error for a test operation whose value does not match.
+	ErrTestFailed = errors.New("test failed")
+)
+
+var pointerUnescaper = strings.NewReplacer("~1", "/", "~0", "~")
+
+// FromPatch returns a Result of a Partial of base with the JSON Patch (RFC 6902) document patch applied.
+// The add, remove, replace and test operations are supported.
+// Each operation's path must be the JSON Pointer of one of fieldNames, which are in gjson syntax,
+// or of a location within one; the FieldMask has the bit of each field that is added, removed or replaced.
+// It is an error if a path is not of a field, which wraps ErrUnknownPath,
+// or if a test fails, which wraps ErrTestFailed.
+func FromPatch[T any](base T, fieldNames []string, patch []byte) result.Result[Partial[T]] {
+	var ops []operation
+	if err := json.Unmarshal(patch, &ops); err != nil {
+		return result.OfError[Partial[T]](err)
+	}
+
+	byteJSON, err := json.Marshal(base)
+	if err != nil {
+		return result.OfError[Partial[T]](err)
+	}
+
+	doc, err := decode(byteJSON)
+	if err != nil {
+		return result.OfError[Partial[T]](err)
+	}
+
+	fields := make(map[string]uint, len(fieldNames))
+	for i, fieldName := range fieldNames {
+		fields[pointer(fieldName)] = uint(i)
+	}
+
+	fieldMask := bitset.New(uint(len(fieldNames)))
+
+	for _, op := range ops {
+		field, ok := fieldOf(fields, op.Path)
+		if !ok {
+			return result.OfError[Partial[T]](fmt.Errorf("%s %q: %w", op.Op, op.Path, ErrUnknownPath))
+		}
+
+		if doc, err = op.apply(doc); err != nil {
+			return result.OfError[Partial[T]](fmt.Errorf("%s %q: %w", op.Op, op.Path, err))
+		}
+
+		if op.Op != "test" {
+			fieldMask.Set(field)
+		}
+	}
+
+	if byteJSON, err = json.Marshal(doc); err != nil {
+		return result.OfError[Partial[T]](err)
+	}
+
+	var value T
+	if err := json.Unmarshal(byteJSON, &value); err != nil {
+		return result.OfError[Partial[T]](err)
+	}
+
+	return result.OfOk(NewPartial(value, fieldMask, fieldNames))
+}
+
+// UnmarshalJSON decodes the JSON Patch document data into x, as by FromPatch with the Value and FieldNames of x.
+// The FieldMask of x is replaced by one of the fields in data.
+func (x *Partial[T]) UnmarshalJSON(data []byte) error {
+	partial, err := FromPatch(x.Value, x.FieldNames, data).Unpack()
+	if err != nil {
+		return err
+	}
+
+	*x = partial
+
+	return nil
+}
+
+// fieldOf returns the bit of the field whose pointer is path or a prefix of it.
+func fieldOf(fields map[string]uint, path string) (uint, bool) {
+	for prefix := path; prefix != ""; prefix = prefix[:strings.LastIndexByte(prefix, '/')] {
+		if i, ok := fields[prefix]; ok {
+			return i, true
+		}
+
+		if !strings.HasPrefix(prefix, "/") {
+			break
+		}
+	}
+
+	return 0, false
+}
+
+// apply returns doc with op applied.
+func (op operation) apply(doc any) (any, error) {
+	tokens := strings.Split(op.Path, "/")[1:]
+	for i, token := range tokens {
+		tokens[i] = pointerUnescaper.Replace(token)
+	}
+
+	var value any
+
+	switch op.Op {
+	case "add", "replace", "test":
+		if op.Value == nil {
+			return nil, errors.New("missing value")
+		}
+
+		var err error
+		if value, err = decode(op.Value); err != nil {
+			return nil, err
+		}
+	case "remove":
+	default:
+		return nil, errors.New("unsupported operation")
+	}
+
+	if op.Op == "test" {
+		got, err := get(doc, tokens)
+		if err != nil {
+			return nil, err
+		}
+
+		if !equal(got, value) {
+			return nil, ErrTestFailed
+		}
+
+		return doc, nil
+	}
+
+	return edit(doc, tokens, op.Op, value)
+}
+
+// get returns the value at the location of tokens in node.
+func get(node any, tokens []string) (any, error) {
+	for _, token := range tokens {
+		switch n := node.(type) {
+		case map[string]any:
+			child, ok := n[token]
+			if !ok {
+				return nil, fmt.Errorf("no member %q", token)
+			}
+
+			node = child
+		case []any:
+			i, err := strconv.Atoi(token)
+			if err != nil || i < 0 || i >= len(n) {
+				return nil, fmt.Errorf("bad index %q", token)
+			}
+
+			node = n[i]
+		default:
+			return nil, fmt.Errorf("no member %q", token)
+		}
+	}
+
+	return node, nil
+}
+
+// edit applies the add, remove or replace op with value at the location of tokens in node
+// and returns the new node.
+func edit(node any, tokens []string, op string, value any) (any, error) {
+	token := tokens[0]
+	last := len(tokens) == 1
+
+	switch node := node.(type) {
+	case map[string]any:
+		child, ok := node[token]
+
+		switch {
+		case last && op == "add":
+			node[token] = value
+
+			return node, nil
+		case !ok:
+			return nil, fmt.Errorf("no member %q", token)
+		case !last:
+			child, err := edit(child, tokens[1:], op, value)
+			if err != nil {
+				return nil, err
+			}
+
+			node[token] = child
+
+			return node, nil
+		case op == "replace":
+			node[token] = value
+		case op == "remove":
+			delete(node, token)
+		}
+
+		return node, nil
+	case []any:
+		if last && op == "add" && token == "-" {
+			return append(node, value), nil
+		}
+
+		i, err := strconv.Atoi(token)
+		switch {
+		case err != nil || i < 0 || i > len(node):
+			return nil, fmt.Errorf("bad index %q", token)
+		case last && op == "add":
+			return append(node[:i], append([]any{value}, node[i:]...)...), nil
+		case i == len(node):
+			return nil, fmt.Errorf("bad index %q", token)
+		case !last:
+			child, err := edit(node[i], tokens[1:], op, value)
+			if err != nil {
+				return nil, err
+			}
+
+			node[i] = child
+
+			return node, nil
+		case op == "replace":
+			node[i] = value
+		case op == "remove":
+			return append(node[:i], node[i+1:]...), nil
+		}
+
+		return node, nil
+	}
+
+	return nil, fmt.Errorf("no member %q", token)
+}
+
+// equalNumbers returns whether x and y are the same number.
+// Integers are compared exactly, since float64 cannot tell apart those beyond 2^53,
+// and other numbers as float64.
+func equalNumbers(x, y json.Number) bool {
+	xi, xOk := new(big.Int).SetString(string(x), 10)
+	yi, yOk := new(big.Int).SetString(string(y), 10)
+	if xOk && yOk {
+		return xi.Cmp(yi) == 0
+	}
+
+	xf, xErr := x.Float64()
+	yf, yErr := y.Float64()
+
+	return xErr == nil && yErr == nil && xf == yf
+}
+
+// decode decodes the JSON data into a generic value, keeping numbers exact.
+func decode(data []byte) (value any, err error) {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+
+	err = decoder.Decode(&value)
+
+	return
+}
+
+// equal returns whether the generic values x and y are equal JSON values.
+// Numbers are compared by value rather than by representation.
+func equal(x, y any) bool {
+	switch x := x.(type) {
+	case json.Number:
+		y, ok := y.(json.Number)
+
+		return ok && equalNumbers(x, y)
+	case map[string]any:
+		y, ok := y.(map[string]any)
+		if !ok || len(x) != len(y) {
+			return false
+		}
+
+		for k, v := range x {
+			if w, ok := y[k]; !ok || !equal(v, w) {
+				return false
+			}
+		}
+
+		return true
+	case []any:
+		y, ok := y.([]any)
+		if !ok || len(x) != len(y) {
+			return false
+		}
+
+		for i := range x {
+			if !equal(x[i], y[i]) {
+				return false
+			}
+		}
+
+		return true
+	}
+
+	return reflect.DeepEqual(x, y)
+}
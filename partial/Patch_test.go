@@ -0,0 +1,167 @@
+package partial_test
+
+import (
+	"encoding/json"
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/binaryphile/valor/partial"
+)
+
+type profile struct {
+	Name    string   `json:"name"`
+	Email   string   `json:"email,omitempty"`
+	Address address  `json:"address"`
+	Tags    []string `json:"tags"`
+	Age     int      `json:"age"`
+}
+
+var profileFields = []string{"name", "email", "address.city", "tags", "age"}
+
+func TestFromPatch(t *testing.T) {
+	base := profile{
+		Name:    "ann",
+		Email:   "ann@example.com",
+		Address: address{City: "Oslo"},
+		Tags:    []string{"a", "c"},
+		Age:     30,
+	}
+
+	tests := []struct {
+		name     string
+		patch    string
+		want     profile
+		wantMask []uint
+	}{
+		{
+			name:     "replace",
+			patch:    `[{"op":"replace","path":"/name","value":"bo"},{"op":"replace","path":"/address/city","value":"Rome"}]`,
+			want:     profile{Name: "bo", Email: "ann@example.com", Address: address{City: "Rome"}, Tags: []string{"a", "c"}, Age: 30},
+			wantMask: []uint{0, 2},
+		},
+		{
+			name:     "remove",
+			patch:    `[{"op":"remove","path":"/email"}]`,
+			want:     profile{Name: "ann", Address: address{City: "Oslo"}, Tags: []string{"a", "c"}, Age: 30},
+			wantMask: []uint{1},
+		},
+		{
+			name:     "add within field",
+			patch:    `[{"op":"add","path":"/tags/1","value":"b"},{"op":"add","path":"/tags/-","value":"d"}]`,
+			want:     profile{Name: "ann", Email: "ann@example.com", Address: address{City: "Oslo"}, Tags: []string{"a", "b", "c", "d"}, Age: 30},
+			wantMask: []uint{3},
+		},
+		{
+			name:     "remove within field",
+			patch:    `[{"op":"remove","path":"/tags/0"}]`,
+			want:     profile{Name: "ann", Email: "ann@example.com", Address: address{City: "Oslo"}, Tags: []string{"c"}, Age: 30},
+			wantMask: []uint{3},
+		},
+		{
+			name:     "test",
+			patch:    `[{"op":"test","path":"/age","value":30.0},{"op":"replace","path":"/age","value":31}]`,
+			want:     profile{Name: "ann", Email: "ann@example.com", Address: address{City: "Oslo"}, Tags: []string{"a", "c"}, Age: 31},
+			wantMask: []uint{4},
+		},
+		{
+			name:  "empty",
+			patch: `[]`,
+			want:  base,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := partial.FromPatch(base, profileFields, []byte(tt.patch)).Unpack()
+			if err != nil {
+				t.Fatalf("FromPatch() error = %v", err)
+			}
+			if !reflect.DeepEqual(got.Value, tt.want) {
+				t.Errorf("FromPatch() Value = %+v, want %+v", got.Value, tt.want)
+			}
+			if mask := maskOf(got); !reflect.DeepEqual(mask, tt.wantMask) {
+				t.Errorf("FromPatch() FieldMask = %v, want %v", mask, tt.wantMask)
+			}
+		})
+	}
+}
+
+func TestFromPatch_errors(t *testing.T) {
+	base := profile{Name: "ann", Tags: []string{"a"}}
+
+	tests := []struct {
+		name    string
+		patch   string
+		wantErr error
+	}{
+		{name: "unknown path", patch: `[{"op":"replace","path":"/password","value":"x"}]`, wantErr: partial.ErrUnknownPath},
+		{name: "unlisted sibling", patch: `[{"op":"replace","path":"/address/zip","value":"x"}]`, wantErr: partial.ErrUnknownPath},
+		{name: "root", patch: `[{"op":"replace","path":"","value":{}}]`, wantErr: partial.ErrUnknownPath},
+		{name: "failed test", patch: `[{"op":"test","path":"/name","value":"bo"}]`, wantErr: partial.ErrTestFailed},
+		{name: "missing member", patch: `[{"op":"remove","path":"/email"}]`},
+		{name: "bad index", patch: `[{"op":"replace","path":"/tags/5","value":"x"}]`},
+		{name: "missing value", patch: `[{"op":"replace","path":"/name"}]`},
+		{name: "unsupported", patch: `[{"op":"move","from":"/name","path":"/email"}]`},
+		{name: "wrong type", patch: `[{"op":"replace","path":"/age","value":"old"}]`},
+		{name: "not a patch", patch: `{"op":"replace"}`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := partial.FromPatch(base, profileFields, []byte(tt.patch)).Error()
+			if err == nil || tt.wantErr != nil && !errors.Is(err, tt.wantErr) {
+				t.Errorf("FromPatch() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestFromPatch_testNumbers(t *testing.T) {
+	base := profile{Age: 1<<53 + 1}
+
+	tests := []struct {
+		value   string
+		wantErr error
+	}{
+		{"9007199254740993", nil},
+		{"9007199254740992", partial.ErrTestFailed},
+	}
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			patch := `[{"op":"test","path":"/age","value":` + tt.value + `}]`
+			if err := partial.FromPatch(base, profileFields, []byte(patch)).Error(); !errors.Is(err, tt.wantErr) {
+				t.Errorf("FromPatch() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestPartial_UnmarshalJSON(t *testing.T) {
+	var got struct {
+		Patch partial.Partial[profile] `json:"patch"`
+	}
+	got.Patch.FieldNames = profileFields
+
+	data := []byte(`{"patch":[{"op":"add","path":"/name","value":"cy"}]}`)
+	if err := json.Unmarshal(data, &got); err != nil {
+		t.Fatalf("Unmarshal() error = %v", err)
+	}
+	if got.Patch.Value.Name != "cy" || !reflect.DeepEqual(maskOf(got.Patch), []uint{0}) {
+		t.Errorf("Unmarshal() = %+v, want name cy", got.Patch)
+	}
+
+	// the patch round-trips
+	if b, err := json.Marshal(got.Patch); err != nil || string(b) != `[{"op":"replace","path":"/name","value":"cy"}]` {
+		t.Errorf("Marshal() = %s %v, want %s", b, err, `[{"op":"replace","path":"/name","value":"cy"}]`)
+	}
+}
+
+// maskOf returns the indexes of the bits set in the FieldMask of x.
+func maskOf[T any](x partial.Partial[T]) []uint {
+	var bits []uint
+
+	for i, ok := x.FieldMask.NextSet(0); ok; i, ok = x.FieldMask.NextSet(i + 1) {
+		bits = append(bits, i)
+	}
+
+	return bits
+}
@@ -0,0 +1,53 @@
+// Copyright 2022 phelmkamp. All rights reserved.
+// Use of this source code is governed by a MIT
+// license that can be found in the LICENSE file.
+
+package result
+
+import (
+	"errors"
+
+	"github.com/binaryphile/valor/optional"
+)
+
+// CodeMapper maps an error to a code, such as an HTTP status.
+// Returns a not-ok Value if it has no code for the error.
+type CodeMapper func(error) optional.Value[int]
+
+// CodeIs returns a CodeMapper that maps err to code if errors.Is(err, target).
+func CodeIs(target error, code int) CodeMapper {
+	return func(err error) optional.Value[int] {
+		return optional.Of(code, errors.Is(err, target))
+	}
+}
+
+// CodeAs returns a CodeMapper that maps err to code if err has an error of type E in its chain.
+func CodeAs[E error](code int) CodeMapper {
+	return CodeFrom(func(E) int { return code })
+}
+
+// CodeFrom returns a CodeMapper that finds the first error of type E in the chain of err
+// and maps it to the result of f.
+// This aids in mapping errors that carry their own code, such as enum.Member.
+func CodeFrom[E error](f func(E) int) CodeMapper {
+	return func(err error) optional.Value[int] {
+		var target E
+		if !errors.As(err, &target) {
+			return optional.OfNotOk[int]()
+		}
+		return optional.OfOk(f(target))
+	}
+}
+
+// Codes returns a CodeMapper that tries each of mappers in order
+// and returns the first ok code.
+func Codes(mappers ...CodeMapper) CodeMapper {
+	return func(err error) optional.Value[int] {
+		for _, mapper := range mappers {
+			if code := mapper(err); code.IsOk() {
+				return code
+			}
+		}
+		return optional.OfNotOk[int]()
+	}
+}
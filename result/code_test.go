@@ -0,0 +1,46 @@
+// Copyright 2022 phelmkamp. All rights reserved.
+// Use of this source code is governed by a MIT
+// license that can be found in the LICENSE file.
+
+package result_test
+
+import (
+	"fmt"
+	"io/fs"
+	"testing"
+
+	"github.com/binaryphile/valor/enum"
+	"github.com/binaryphile/valor/optional"
+	"github.com/binaryphile/valor/result"
+)
+
+type code string
+
+var codes, _ = enum.Of[code, struct{}]("not_found", "conflict")
+
+func TestCodes(t *testing.T) {
+	statuses := map[string]int{"not_found": 404, "conflict": 409}
+	mapper := result.Codes(
+		result.CodeIs(errFail, 400),
+		result.CodeAs[*fs.PathError](403),
+		result.CodeFrom(func(m enum.Member[code, struct{}]) int { return statuses[m.Name()] }),
+	)
+
+	tests := []struct {
+		name string
+		err  error
+		want optional.Value[int]
+	}{
+		{"sentinel", fmt.Errorf("wrapped: %w", errFail), optional.OfOk(400)},
+		{"type", &fs.PathError{Path: "/foo"}, optional.OfOk(403)},
+		{"member", fmt.Errorf("wrapped: %w", codes.Member("conflict").MustOk()), optional.OfOk(409)},
+		{"unmapped", errSkipped, optional.OfNotOk[int]()},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := mapper(tt.err); got != tt.want {
+				t.Errorf("Codes() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
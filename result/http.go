@@ -0,0 +1,81 @@
+// Copyright 2022 phelmkamp. All rights reserved.
+// Use of this source code is governed by a MIT
+// license that can be found in the LICENSE file.
+
+package result
+
+import (
+	"encoding/json"
+	"net/http"
+)
+
+// Problem is an RFC 7807 problem details object.
+type Problem struct {
+	Type     string `json:"type"`
+	Title    string `json:"title"`
+	Status   int    `json:"status"`
+	Detail   string `json:"detail,omitempty"`
+	Instance string `json:"instance,omitempty"`
+}
+
+// ProblemOf creates a Problem of err with status.
+// The detail is the text of err, which clients see,
+// so err should be nil, for no detail, when its text is internal.
+func ProblemOf(status int, err error) Problem {
+	problem := Problem{
+		Type:   "about:blank",
+		Title:  http.StatusText(status),
+		Status: status,
+	}
+	if err != nil {
+		problem.Detail = err.Error()
+	}
+	return problem
+}
+
+// Handler returns an http.Handler that calls f and writes the Result.
+// A value is written as JSON with status 200.
+// An error is written as an application/problem+json Problem
+// with the status given by codes and the error text as its detail,
+// or with status 500 and no detail if codes has none for the error.
+func Handler[T any](f func(*http.Request) Result[T], codes ...CodeMapper) http.Handler {
+	mapper := Codes(codes...)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		res := FlatMap(marshal[T], f(r))
+		if b, ok := res.Value().Unpack(); ok {
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusOK)
+			_, _ = w.Write(b)
+			return
+		}
+		err := res.Error()
+		if err == nil {
+			// OfError(nil) has neither value nor error, so there is nothing to write.
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+		status, ok := mapper(err).Unpack()
+		if !ok {
+			// the text of an unexpected error is internal
+			status, err = http.StatusInternalServerError, nil
+		}
+		problem := ProblemOf(status, err)
+		problem.Instance = r.URL.Path
+		writeProblem(w, problem)
+	})
+}
+
+func marshal[T any](v T) Result[[]byte] {
+	return Of(json.Marshal(v))
+}
+
+func writeProblem(w http.ResponseWriter, problem Problem) {
+	b, err := json.Marshal(problem)
+	if err != nil {
+		http.Error(w, http.StatusText(problem.Status), problem.Status)
+		return
+	}
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(problem.Status)
+	_, _ = w.Write(b)
+}
@@ -0,0 +1,112 @@
+// Copyright 2022 phelmkamp. All rights reserved.
+// Use of this source code is governed by a MIT
+// license that can be found in the LICENSE file.
+
+package result_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/binaryphile/valor/result"
+)
+
+func TestHandler(t *testing.T) {
+	handler := result.Handler(func(r *http.Request) result.Result[map[string]int] {
+		switch r.URL.Query().Get("case") {
+		case "fail":
+			return result.OfError[map[string]int](fmt.Errorf("lookup: %w", errFail))
+		case "other":
+			return result.OfError[map[string]int](errSkipped)
+		}
+		return result.OfOk(map[string]int{"n": 1})
+	}, result.CodeIs(errFail, http.StatusNotFound))
+
+	tests := []struct {
+		name        string
+		query       string
+		wantStatus  int
+		wantType    string
+		wantProblem result.Problem
+		wantBody    string
+	}{
+		{
+			name:       "ok",
+			wantStatus: http.StatusOK,
+			wantType:   "application/json",
+			wantBody:   `{"n":1}`,
+		},
+		{
+			name:       "mapped",
+			query:      "?case=fail",
+			wantStatus: http.StatusNotFound,
+			wantType:   "application/problem+json",
+			wantProblem: result.Problem{
+				Type:     "about:blank",
+				Title:    "Not Found",
+				Status:   http.StatusNotFound,
+				Detail:   "lookup: fail",
+				Instance: "/widgets",
+			},
+		},
+		{
+			name:       "unmapped",
+			query:      "?case=other",
+			wantStatus: http.StatusInternalServerError,
+			wantType:   "application/problem+json",
+			wantProblem: result.Problem{
+				Type:     "about:blank",
+				Title:    "Internal Server Error",
+				Status:   http.StatusInternalServerError,
+				Instance: "/widgets",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec := httptest.NewRecorder()
+			handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/widgets"+tt.query, nil))
+
+			if rec.Code != tt.wantStatus {
+				t.Errorf("status = %v, want %v", rec.Code, tt.wantStatus)
+			}
+			if got := rec.Header().Get("Content-Type"); got != tt.wantType {
+				t.Errorf("Content-Type = %v, want %v", got, tt.wantType)
+			}
+			if tt.wantBody != "" {
+				if got := rec.Body.String(); got != tt.wantBody {
+					t.Errorf("body = %v, want %v", got, tt.wantBody)
+				}
+				return
+			}
+			var got result.Problem
+			if err := json.Unmarshal(rec.Body.Bytes(), &got); err != nil {
+				t.Fatalf("json.Unmarshal() error = %v", err)
+			}
+			if got != tt.wantProblem {
+				t.Errorf("problem = %+v, want %+v", got, tt.wantProblem)
+			}
+		})
+	}
+}
+
+func TestHandler_marshalError(t *testing.T) {
+	handler := result.Handler(func(*http.Request) result.Result[func()] {
+		return result.OfOk(func() {})
+	})
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/", nil))
+	if rec.Code != http.StatusInternalServerError {
+		t.Errorf("status = %v, want %v", rec.Code, http.StatusInternalServerError)
+	}
+	var got result.Problem
+	if err := json.Unmarshal(rec.Body.Bytes(), &got); err != nil {
+		t.Fatalf("json.Unmarshal() error = %v", err)
+	}
+	if got.Detail != "" {
+		t.Errorf("Detail = %v, want none", got.Detail)
+	}
+}
@@ -0,0 +1,102 @@
+// Copyright 2022 phelmkamp. All rights reserved.
+// Use of this source code is governed by a MIT
+// license that can be found in the LICENSE file.
+
+package result
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"syscall"
+)
+
+// Exit codes used by Runner when no CodeMapper has a code for an error.
+const (
+	ExitFailure     = 1
+	ExitInterrupted = 130
+)
+
+// Runner runs the main function of a command-line program.
+// The zero value is ready to use.
+type Runner struct {
+	// Codes maps errors to exit codes.
+	// Errors without a code exit with ExitInterrupted if the context was canceled
+	// or ExitFailure otherwise.
+	Codes CodeMapper
+	// Exit is called with the exit code. nil means os.Exit.
+	Exit func(int)
+	// Stderr receives the error message. nil means os.Stderr.
+	Stderr io.Writer
+	// Signals cancel the context given to the main function.
+	// Empty means os.Interrupt and syscall.SIGTERM, never every signal.
+	Signals []os.Signal
+}
+
+// Main runs f with a Runner using codes.
+// It is intended to be the only call in a program's main function:
+//
+//	func main() {
+//		result.Main(run, result.CodeIs(errUsage, 2))
+//	}
+func Main(f func(context.Context) Result[int], codes ...CodeMapper) {
+	Runner{Codes: Codes(codes...)}.Run(f)
+}
+
+// Run calls f with a context that is canceled when one of the signals is received,
+// then exits with the contained code.
+// If the Result contains an error, Run prints it to stderr and exits with its mapped code.
+func (runner Runner) Run(f func(context.Context) Result[int]) {
+	exit := runner.Exit
+	if exit == nil {
+		exit = os.Exit
+	}
+
+	exit(runner.run(f))
+}
+
+func (runner Runner) run(f func(context.Context) Result[int]) int {
+	signals := runner.Signals
+	if len(signals) == 0 {
+		signals = []os.Signal{os.Interrupt, syscall.SIGTERM}
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), signals...)
+	defer stop()
+
+	res := f(ctx)
+
+	var code int
+	if res.Value().Ok(&code) {
+		return code
+	}
+
+	err := res.Error()
+	if err == nil {
+		// OfError(nil) is not a failure.
+		return 0
+	}
+
+	stderr := runner.Stderr
+	if stderr == nil {
+		stderr = os.Stderr
+	}
+
+	_, _ = fmt.Fprintf(stderr, "%s: %v\n", filepath.Base(os.Args[0]), err)
+
+	if runner.Codes != nil {
+		if code, ok := runner.Codes(err).Unpack(); ok {
+			return code
+		}
+	}
+
+	if errors.Is(err, context.Canceled) || ctx.Err() != nil {
+		return ExitInterrupted
+	}
+
+	return ExitFailure
+}
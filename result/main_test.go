@@ -0,0 +1,56 @@
+// Copyright 2022 phelmkamp. All rights reserved.
+// Use of this source code is governed by a MIT
+// license that can be found in the LICENSE file.
+
+package result_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/binaryphile/valor/result"
+)
+
+func TestRunner_Run(t *testing.T) {
+	prog := filepath.Base(os.Args[0])
+
+	tests := []struct {
+		name       string
+		res        result.Result[int]
+		wantCode   int
+		wantStderr string
+	}{
+		{"ok", result.OfOk(0), 0, ""},
+		{"ok nonzero", result.OfOk(3), 3, ""},
+		{"mapped", result.OfError[int](fmt.Errorf("parse flags: %w", errFail)), 2, prog + ": parse flags: fail\n"},
+		{"member", result.OfError[int](codes.Member("conflict").MustOk()), 9, prog + ": conflict\n"},
+		{"unmapped", result.OfError[int](errSkipped), result.ExitFailure, prog + ": skipped row\n"},
+		{"canceled", result.OfError[int](context.Canceled), result.ExitInterrupted, prog + ": context canceled\n"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var stderr strings.Builder
+			gotCode := -1
+
+			result.Runner{
+				Codes: result.Codes(
+					result.CodeIs(errFail, 2),
+					result.CodeIs(codes.Member("conflict").MustOk(), 9),
+				),
+				Exit:   func(code int) { gotCode = code },
+				Stderr: &stderr,
+			}.Run(func(context.Context) result.Result[int] { return tt.res })
+
+			if gotCode != tt.wantCode {
+				t.Errorf("exit code = %v, want %v", gotCode, tt.wantCode)
+			}
+			if got := stderr.String(); got != tt.wantStderr {
+				t.Errorf("stderr = %q, want %q", got, tt.wantStderr)
+			}
+		})
+	}
+}
@@ -0,0 +1,63 @@
+// Copyright 2022 phelmkamp. All rights reserved.
+// Use of this source code is governed by a MIT
+// license that can be found in the LICENSE file.
+
+//go:build unix
+
+package result_test
+
+import (
+	"context"
+	"os"
+	"strings"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/binaryphile/valor/result"
+)
+
+func TestRunner_Run_signal(t *testing.T) {
+	gotCode := -1
+
+	result.Runner{
+		Exit:    func(code int) { gotCode = code },
+		Stderr:  &strings.Builder{},
+		Signals: []os.Signal{syscall.SIGUSR1},
+	}.Run(func(ctx context.Context) result.Result[int] {
+		if err := syscall.Kill(os.Getpid(), syscall.SIGUSR1); err != nil {
+			t.Fatalf("Kill() error = %v", err)
+		}
+		<-ctx.Done()
+		return result.OfError[int](ctx.Err())
+	})
+
+	if gotCode != result.ExitInterrupted {
+		t.Errorf("exit code = %v, want %v", gotCode, result.ExitInterrupted)
+	}
+}
+
+// TestRunner_Run_noSignals checks that empty Signals do not relay every signal, such as SIGWINCH.
+func TestRunner_Run_noSignals(t *testing.T) {
+	gotCode := -1
+
+	result.Runner{
+		Exit:    func(code int) { gotCode = code },
+		Stderr:  &strings.Builder{},
+		Signals: []os.Signal{},
+	}.Run(func(ctx context.Context) result.Result[int] {
+		if err := syscall.Kill(os.Getpid(), syscall.SIGWINCH); err != nil {
+			t.Fatalf("Kill() error = %v", err)
+		}
+		select {
+		case <-ctx.Done():
+			return result.OfError[int](ctx.Err())
+		case <-time.After(100 * time.Millisecond):
+			return result.OfOk(0)
+		}
+	})
+
+	if gotCode != 0 {
+		t.Errorf("exit code = %v, want %v", gotCode, 0)
+	}
+}
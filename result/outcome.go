@@ -0,0 +1,129 @@
+// Copyright 2022 phelmkamp. All rights reserved.
+// Use of this source code is governed by a MIT
+// license that can be found in the LICENSE file.
+
+package result
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/binaryphile/valor/optional"
+)
+
+// Outcome contains either a value or an error, along with any warnings.
+// Warnings are non-fatal errors, such as a skipped row,
+// that accompany the value or error rather than replacing it.
+type Outcome[T any] struct {
+	res      Result[T]
+	warnings []error
+}
+
+// OutcomeOf creates an Outcome of res with warnings.
+// nil warnings are dropped.
+func OutcomeOf[T any](res Result[T], warnings ...error) Outcome[T] {
+	return Outcome[T]{res: res}.Warn(warnings...)
+}
+
+// Warn creates an Outcome of res with warnings.
+// nil warnings are dropped.
+func (res Result[T]) Warn(warnings ...error) Outcome[T] {
+	return OutcomeOf(res, warnings...)
+}
+
+// Warn returns an Outcome with warnings appended to the existing ones.
+// nil warnings are dropped.
+func (out Outcome[T]) Warn(warnings ...error) Outcome[T] {
+	for _, w := range warnings {
+		if w == nil {
+			continue
+		}
+		// Limit capacity so that append copies rather than sharing the backing array.
+		out.warnings = append(out.warnings[:len(out.warnings):len(out.warnings)], w)
+	}
+	return out
+}
+
+// Warnf returns an Outcome with a warning formatted by fmt.Errorf appended.
+func (out Outcome[T]) Warnf(format string, a ...any) Outcome[T] {
+	return out.Warn(fmt.Errorf(format, a...))
+}
+
+// Warnings returns a copy of the warnings.
+func (out Outcome[T]) Warnings() []error {
+	return append([]error(nil), out.warnings...)
+}
+
+// HasWarnings returns whether out contains any warnings.
+func (out Outcome[T]) HasWarnings() bool {
+	return len(out.warnings) > 0
+}
+
+// Result returns the underlying Result, dropping any warnings.
+func (out Outcome[T]) Result() Result[T] {
+	return out.res
+}
+
+// IsError returns whether out contains an error.
+func (out Outcome[T]) IsError() bool {
+	return out.res.IsError()
+}
+
+// Error returns the contained error, or nil if no error is present.
+func (out Outcome[T]) Error() error {
+	return out.res.Error()
+}
+
+// Value returns an optional.Value containing either the
+// underlying value or nothing.
+func (out Outcome[T]) Value() optional.Value[T] {
+	return out.res.Value()
+}
+
+// Unpack returns the underlying value and error.
+func (out Outcome[T]) Unpack() (T, error) {
+	return out.res.Unpack()
+}
+
+// String returns out formatted as a string.
+func (out Outcome[T]) String() string {
+	return fmt.Sprintf("{%v %v %v}", out.res.v, out.res.err, out.warnings)
+}
+
+// MarshalJSON encodes out as a JSON object.
+// The object has a "value" member if ok or an "error" member with the error message otherwise,
+// plus a "warnings" member with the warning messages if there are any.
+func (out Outcome[T]) MarshalJSON() ([]byte, error) {
+	var obj struct {
+		Value    *T       `json:"value,omitempty"`
+		Error    string   `json:"error,omitempty"`
+		Warnings []string `json:"warnings,omitempty"`
+	}
+	if err := out.res.Error(); err != nil {
+		obj.Error = err.Error()
+	} else if out.res.err == nil {
+		obj.Value = &out.res.v
+	}
+	for _, w := range out.warnings {
+		obj.Warnings = append(obj.Warnings, w.Error())
+	}
+	return json.Marshal(obj)
+}
+
+// OutcomeMap returns an Outcome of the result of f on the underlying value.
+// Returns an Outcome of the underlying error if out contains an error.
+// Warnings are carried through either way.
+func OutcomeMap[T, T2 any](f func(T) T2, out Outcome[T]) Outcome[T2] {
+	return Outcome[T2]{res: Map(f, out.res), warnings: out.warnings}
+}
+
+// OutcomeFlatMap returns the result of f on the underlying value,
+// with the warnings of out ahead of those returned by f.
+// Returns an Outcome of the underlying error if out contains an error.
+func OutcomeFlatMap[T, T2 any](f func(T) Outcome[T2], out Outcome[T]) Outcome[T2] {
+	if out.res.err != nil {
+		return Outcome[T2]{res: Result[T2]{err: out.res.err}, warnings: out.warnings}
+	}
+	next := f(out.res.v)
+	return Outcome[T2]{res: next.res, warnings: out.warnings}.Warn(next.warnings...)
+}
@@ -0,0 +1,149 @@
+// Copyright 2022 phelmkamp. All rights reserved.
+// Use of this source code is governed by a MIT
+// license that can be found in the LICENSE file.
+
+package result_test
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"strconv"
+	"testing"
+
+	"github.com/binaryphile/valor/result"
+)
+
+// type checks
+var (
+	_ json.Marshaler = result.Outcome[int]{}
+)
+
+var errSkipped = errors.New("skipped row")
+
+func ExampleOutcome() {
+	parse := func(s string) result.Outcome[int] {
+		n, err := strconv.Atoi(s)
+		if err != nil {
+			return result.OfOk(0).Warn(fmt.Errorf("coerced %q to 0: %w", s, err))
+		}
+		return result.OfOk(n).Warn()
+	}
+
+	out := result.OutcomeFlatMap(parse, result.OfOk("x").Warn(errSkipped))
+	fmt.Println(out.Value().MustOk(), len(out.Warnings()))
+
+	b, _ := json.Marshal(result.OutcomeMap(strconv.Itoa, out))
+	fmt.Println(string(b))
+	// Output: 0 2
+	// {"value":"0","warnings":["skipped row","coerced \"x\" to 0: strconv.Atoi: parsing \"x\": invalid syntax"]}
+}
+
+func TestOutcomeOf(t *testing.T) {
+	got := result.OutcomeOf(result.OfOk(1), nil, errSkipped, nil)
+	if want := []error{errSkipped}; !reflect.DeepEqual(got.Warnings(), want) {
+		t.Errorf("Warnings() = %v, want %v", got.Warnings(), want)
+	}
+	if got.Result() != result.OfOk(1) {
+		t.Errorf("Result() = %v, want %v", got.Result(), result.OfOk(1))
+	}
+	if got := result.OutcomeOf(result.OfOk(1)); got.HasWarnings() {
+		t.Errorf("HasWarnings() = %v, want %v", got.HasWarnings(), false)
+	}
+}
+
+func TestOutcome_Warn(t *testing.T) {
+	base := result.OfOk(1).Warn(errSkipped)
+	// appending to two copies of base must not share storage
+	a := base.Warnf("a")
+	b := base.Warnf("b")
+	if got := a.Warnings()[1].Error(); got != "a" {
+		t.Errorf("Warnings()[1] = %v, want %v", got, "a")
+	}
+	if got := b.Warnings()[1].Error(); got != "b" {
+		t.Errorf("Warnings()[1] = %v, want %v", got, "b")
+	}
+	if got := len(base.Warnings()); got != 1 {
+		t.Errorf("len(Warnings()) = %v, want %v", got, 1)
+	}
+}
+
+func TestOutcome_Error(t *testing.T) {
+	out := result.OfError[int](errFail).Warn(errSkipped)
+	if !out.IsError() {
+		t.Errorf("IsError() = %v, want %v", out.IsError(), true)
+	}
+	if got := out.Error(); got != errFail {
+		t.Errorf("Error() = %v, want %v", got, errFail)
+	}
+	if got := out.Value(); got.IsOk() {
+		t.Errorf("Value().IsOk() = %v, want %v", got.IsOk(), false)
+	}
+}
+
+func TestOutcomeMap(t *testing.T) {
+	got := result.OutcomeMap(strconv.Itoa, result.OfError[int](errFail).Warn(errSkipped))
+	if got.Result() != result.OfError[string](errFail) {
+		t.Errorf("OutcomeMap().Result() = %v, want %v", got.Result(), result.OfError[string](errFail))
+	}
+	if want := []error{errSkipped}; !reflect.DeepEqual(got.Warnings(), want) {
+		t.Errorf("OutcomeMap().Warnings() = %v, want %v", got.Warnings(), want)
+	}
+}
+
+func TestOutcomeFlatMap(t *testing.T) {
+	called := false
+	f := func(int) result.Outcome[string] {
+		called = true
+		return result.OfOk("foo").Warn(errFail)
+	}
+	got := result.OutcomeFlatMap(f, result.OfError[int](errFail).Warn(errSkipped))
+	if called {
+		t.Errorf("OutcomeFlatMap() called f on error")
+	}
+	if want := []error{errSkipped}; !reflect.DeepEqual(got.Warnings(), want) {
+		t.Errorf("OutcomeFlatMap().Warnings() = %v, want %v", got.Warnings(), want)
+	}
+
+	got = result.OutcomeFlatMap(f, result.OfOk(1).Warn(errSkipped))
+	if want := []error{errSkipped, errFail}; !reflect.DeepEqual(got.Warnings(), want) {
+		t.Errorf("OutcomeFlatMap().Warnings() = %v, want %v", got.Warnings(), want)
+	}
+	if got.Result() != result.OfOk("foo") {
+		t.Errorf("OutcomeFlatMap().Result() = %v, want %v", got.Result(), result.OfOk("foo"))
+	}
+}
+
+func TestOutcome_String(t *testing.T) {
+	if got := result.OfOk(1).Warn(errSkipped).String(); got != "{1 <nil> [skipped row]}" {
+		t.Errorf("String() = %v, want %v", got, "{1 <nil> [skipped row]}")
+	}
+	if got := result.OfError[int](errFail).Warn().String(); got != "{0 fail []}" {
+		t.Errorf("String() = %v, want %v", got, "{0 fail []}")
+	}
+}
+
+func TestOutcome_MarshalJSON(t *testing.T) {
+	tests := []struct {
+		name string
+		out  result.Outcome[int]
+		want string
+	}{
+		{"ok", result.OfOk(1).Warn(), `{"value":1}`},
+		{"zero", result.OfOk(0).Warn(), `{"value":0}`},
+		{"error", result.OfError[int](errFail).Warn(errSkipped), `{"error":"fail","warnings":["skipped row"]}`},
+		{"nil error", result.OfError[int](nil).Warn(), `{}`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := json.Marshal(tt.out)
+			if err != nil {
+				t.Fatalf("MarshalJSON() error = %v", err)
+			}
+			if string(got) != tt.want {
+				t.Errorf("MarshalJSON() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
@@ -145,6 +145,25 @@ func (res Result[T]) OfError() Result[T] {
 	return OfError[T](res.err)
 }
 
+// Map returns a Result of the result of f on the underlying value.
+// Returns a Result of the underlying error if res contains an error.
+func Map[T, T2 any](f func(T) T2, res Result[T]) Result[T2] {
+	// Check err directly so that errNil carries through.
+	if res.err != nil {
+		return Result[T2]{err: res.err}
+	}
+	return OfOk(f(res.v))
+}
+
+// FlatMap returns the result of f on the underlying value.
+// Returns a Result of the underlying error if res contains an error.
+func FlatMap[T, T2 any](f func(T) Result[T2], res Result[T]) Result[T2] {
+	if res.err != nil {
+		return Result[T2]{err: res.err}
+	}
+	return f(res.v)
+}
+
 // Transpose converts res to an optional.Value of Result.
 // Returns a not-ok optional.Value if the underlying optional.Value is not ok.
 // Otherwise, returns an ok optional.Value of a Result that contains the underlying value or error.
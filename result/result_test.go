@@ -93,7 +93,7 @@ func Example() {
 
 func mid(fail bool) (string, error) {
 	var s string
-	if res := result.Of(leaf(fail)); !optional.Map(res.Value(), strconv.Itoa).Ok(&s) {
+	if res := result.Of(leaf(fail)); !optional.Map(strconv.Itoa, res.Value()).Ok(&s) {
 		return "", res.Errorf("leaf() failed: %w").Error()
 	}
 	return "", nil
@@ -289,3 +289,28 @@ func TestResult_Unpack(t *testing.T) {
 		t.Errorf("Unpack() = %v %v, want %v %v", v, err, "foo", nil)
 	}
 }
+
+func TestMap(t *testing.T) {
+	if got := result.Map(strconv.Itoa, result.OfOk(1)); got != result.OfOk("1") {
+		t.Errorf("Map() = %v, want %v", got, result.OfOk("1"))
+	}
+	if got := result.Map(strconv.Itoa, result.OfError[int](errFail)); got != result.OfError[string](errFail) {
+		t.Errorf("Map() = %v, want %v", got, result.OfError[string](errFail))
+	}
+	if got := result.Map(strconv.Itoa, result.OfError[int](nil)); got.Value().IsOk() {
+		t.Errorf("Map().Value().IsOk() after OfError(nil) = %v, want %v", got.Value().IsOk(), false)
+	}
+}
+
+func TestFlatMap(t *testing.T) {
+	atoi := func(s string) result.Result[int] { return result.Of(strconv.Atoi(s)) }
+	if got := result.FlatMap(atoi, result.OfOk("1")); got != result.OfOk(1) {
+		t.Errorf("FlatMap() = %v, want %v", got, result.OfOk(1))
+	}
+	if got := result.FlatMap(atoi, result.OfError[string](errFail)); got != result.OfError[int](errFail) {
+		t.Errorf("FlatMap() = %v, want %v", got, result.OfError[int](errFail))
+	}
+	if got := result.FlatMap(atoi, result.OfOk("x")); !got.IsError() {
+		t.Errorf("FlatMap().IsError() = %v, want %v", got.IsError(), true)
+	}
+}
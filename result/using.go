@@ -0,0 +1,49 @@
+// Copyright 2022 phelmkamp. All rights reserved.
+// Use of this source code is governed by a MIT
+// license that can be found in the LICENSE file.
+
+package result
+
+import (
+	"errors"
+	"io"
+)
+
+// Using opens a resource, calls use with it and closes it.
+// The resource is always closed once opened, and an error from Close
+// is joined with any error from use by errors.Join.
+// Returns the error from open without calling use if open fails.
+func Using[R io.Closer, T any](open func() Result[R], use func(R) Result[T]) Result[T] {
+	return UsingAll([]func() Result[R]{open}, func(resources []R) Result[T] {
+		return use(resources[0])
+	})
+}
+
+// UsingAll is like Using with several resources, opened in order and closed in reverse order.
+// If an open fails, the resources already opened are closed and use is not called.
+func UsingAll[R io.Closer, T any](opens []func() Result[R], use func([]R) Result[T]) (res Result[T]) {
+	resources := make([]R, 0, len(opens))
+
+	defer func() {
+		var closeErrs []error
+		for i := len(resources) - 1; i >= 0; i-- {
+			if err := resources[i].Close(); err != nil {
+				closeErrs = append(closeErrs, err)
+			}
+		}
+		if closeErrs != nil {
+			res = OfError[T](errors.Join(append([]error{res.Error()}, closeErrs...)...))
+		}
+	}()
+
+	for _, open := range opens {
+		var resource R
+		opened := open()
+		if !opened.Value().Ok(&resource) {
+			return OfError[T](opened.err)
+		}
+		resources = append(resources, resource)
+	}
+
+	return use(resources)
+}
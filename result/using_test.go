@@ -0,0 +1,127 @@
+// Copyright 2022 phelmkamp. All rights reserved.
+// Use of this source code is governed by a MIT
+// license that can be found in the LICENSE file.
+
+package result_test
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/binaryphile/valor/result"
+)
+
+var errClose = errors.New("close failed")
+
+type resource struct {
+	name   string
+	err    error
+	closed *[]string
+}
+
+func (r resource) Close() error {
+	*r.closed = append(*r.closed, r.name)
+	return r.err
+}
+
+func opener(name string, err error, closed *[]string) func() result.Result[resource] {
+	return func() result.Result[resource] {
+		return result.OfOk(resource{name: name, err: err, closed: closed})
+	}
+}
+
+func TestUsing(t *testing.T) {
+	tests := []struct {
+		name     string
+		closeErr error
+		useErr   error
+		wantErrs []error
+	}{
+		{"ok", nil, nil, nil},
+		{"use error", nil, errFail, []error{errFail}},
+		{"close error", errClose, nil, []error{errClose}},
+		{"both", errClose, errFail, []error{errFail, errClose}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var closed []string
+			got := result.Using(opener("a", tt.closeErr, &closed), func(r resource) result.Result[string] {
+				return result.Of(r.name, tt.useErr)
+			})
+
+			if !reflect.DeepEqual(closed, []string{"a"}) {
+				t.Errorf("closed = %v, want %v", closed, []string{"a"})
+			}
+			if tt.wantErrs == nil {
+				if got != result.OfOk("a") {
+					t.Errorf("Using() = %v, want %v", got, result.OfOk("a"))
+				}
+				return
+			}
+			for _, want := range tt.wantErrs {
+				if !got.ErrorIs(want) {
+					t.Errorf("Using().ErrorIs(%v) = %v, want %v", want, false, true)
+				}
+			}
+		})
+	}
+}
+
+func TestUsing_openError(t *testing.T) {
+	called := false
+	got := result.Using(func() result.Result[resource] {
+		return result.OfError[resource](errFail)
+	}, func(resource) result.Result[int] {
+		called = true
+		return result.OfOk(1)
+	})
+
+	if called {
+		t.Errorf("Using() called use after open failed")
+	}
+	if got != result.OfError[int](errFail) {
+		t.Errorf("Using() = %v, want %v", got, result.OfError[int](errFail))
+	}
+}
+
+func TestUsingAll(t *testing.T) {
+	var closed []string
+	got := result.UsingAll([]func() result.Result[resource]{
+		opener("a", nil, &closed),
+		opener("b", errClose, &closed),
+		opener("c", nil, &closed),
+	}, func(rs []resource) result.Result[int] {
+		return result.OfOk(len(rs))
+	})
+
+	if want := []string{"c", "b", "a"}; !reflect.DeepEqual(closed, want) {
+		t.Errorf("closed = %v, want %v", closed, want)
+	}
+	if !got.ErrorIs(errClose) {
+		t.Errorf("UsingAll().ErrorIs(errClose) = %v, want %v", false, true)
+	}
+}
+
+func TestUsingAll_openError(t *testing.T) {
+	var closed []string
+	called := false
+	got := result.UsingAll([]func() result.Result[resource]{
+		opener("a", nil, &closed),
+		func() result.Result[resource] { return result.OfError[resource](errFail) },
+		opener("c", nil, &closed),
+	}, func([]resource) result.Result[int] {
+		called = true
+		return result.OfOk(0)
+	})
+
+	if called {
+		t.Errorf("UsingAll() called use after open failed")
+	}
+	if want := []string{"a"}; !reflect.DeepEqual(closed, want) {
+		t.Errorf("closed = %v, want %v", closed, want)
+	}
+	if got != result.OfError[int](errFail) {
+		t.Errorf("UsingAll() = %v, want %v", got, result.OfError[int](errFail))
+	}
+}
@@ -0,0 +1,11 @@
+// Copyright 2022 phelmkamp. All rights reserved.
+// Use of this source code is governed by a MIT
+// license that can be found in the LICENSE file.
+
+// Package schema provides JSON Schema descriptions of Go types, including valor types.
+// Schemas follow JSON Schema 2020-12, so they may also be used as OpenAPI 3.1 schema objects.
+//
+// Schemas describe how values actually encode.
+// The tuple types encode as objects rather than fixed-length arrays,
+// so describing them with prefixItems is out of scope until they encode as arrays.
+package schema
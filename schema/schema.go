@@ -0,0 +1,139 @@
+// Copyright 2022 phelmkamp. All rights reserved.
+// Use of this source code is governed by a MIT
+// license that can be found in the LICENSE file.
+
+package schema
+
+import (
+	"encoding/json"
+	"reflect"
+
+	"github.com/binaryphile/valor/enum"
+)
+
+// Schema is a JSON Schema.
+// The zero Schema accepts any value.
+type Schema struct {
+	Ref                  string             `json:"$ref,omitempty"`
+	Defs                 map[string]*Schema `json:"$defs,omitempty"`
+	Type                 Types              `json:"type,omitempty"`
+	Format               string             `json:"format,omitempty"`
+	ContentEncoding      string             `json:"contentEncoding,omitempty"`
+	Enum                 []any              `json:"enum,omitempty"`
+	Properties           map[string]*Schema `json:"properties,omitempty"`
+	Required             []string           `json:"required,omitempty"`
+	AdditionalProperties *Schema            `json:"additionalProperties,omitempty"`
+	Items                *Schema            `json:"items,omitempty"`
+	PrefixItems          []*Schema          `json:"prefixItems,omitempty"`
+	MinItems             *int               `json:"minItems,omitempty"`
+	MaxItems             *int               `json:"maxItems,omitempty"`
+	UniqueItems          bool               `json:"uniqueItems,omitempty"`
+	OneOf                []*Schema          `json:"oneOf,omitempty"`
+	Not                  *Schema            `json:"not,omitempty"`
+}
+
+// Types is the set of JSON types a Schema accepts.
+// It encodes as a single string if it has one type.
+type Types []string
+
+// JSON types.
+const (
+	Array   = "array"
+	Boolean = "boolean"
+	Integer = "integer"
+	Null    = "null"
+	Number  = "number"
+	Object  = "object"
+	String  = "string"
+)
+
+// Never is a Schema that accepts no value.
+var Never = &Schema{Not: &Schema{}}
+
+// MarshalJSON encodes x as a string if it has one type, otherwise as an array.
+func (x Types) MarshalJSON() ([]byte, error) {
+	if len(x) == 1 {
+		return json.Marshal(x[0])
+	}
+
+	return json.Marshal([]string(x))
+}
+
+// Of creates a Schema that accepts values of types.
+func Of(types ...string) *Schema {
+	return &Schema{Type: types}
+}
+
+// Enum creates a Schema that accepts the names of the members of e.
+func Enum[T ~string, A any](e enum.Enum[T, A]) *Schema {
+	names := e.Names()
+
+	values := make([]any, len(names))
+	for i, name := range names {
+		values[i] = name
+	}
+
+	return &Schema{Type: Types{String}, Enum: values}
+}
+
+// Optional creates a Schema that accepts null or the values s accepts,
+// as does an optional.Value.
+func Optional(s *Schema) *Schema {
+	switch {
+	case reflect.DeepEqual(*s, Schema{}):
+		return s
+	case len(s.Type) > 0 && s.Ref == "" && s.OneOf == nil && s.Not == nil:
+		nullable := *s
+		nullable.Type = append(s.Type[:len(s.Type):len(s.Type)], Null)
+
+		if s.Enum != nil {
+			nullable.Enum = append(s.Enum[:len(s.Enum):len(s.Enum)], nil)
+		}
+
+		return &nullable
+	}
+
+	return &Schema{OneOf: []*Schema{s, Of(Null)}}
+}
+
+// Outcome creates a Schema that accepts the encoding of a result.Outcome of a value s accepts:
+// an object with either a "value" member or an "error" member with the error message,
+// and a "warnings" member with the warning messages.
+// A result.Result has no such encoding; its fields are unexported, so it encodes as an empty object.
+func Outcome(s *Schema) *Schema {
+	warnings := &Schema{Type: Types{Array}, Items: Of(String)}
+
+	return &Schema{
+		OneOf: []*Schema{
+			{
+				Type:                 Types{Object},
+				Properties:           map[string]*Schema{"value": s, "warnings": warnings},
+				AdditionalProperties: Never,
+			},
+			{
+				Type:                 Types{Object},
+				Properties:           map[string]*Schema{"error": Of(String), "warnings": warnings},
+				Required:             []string{"error"},
+				AdditionalProperties: Never,
+			},
+		},
+	}
+}
+
+// Patch creates a Schema that accepts a JSON Patch (RFC 6902),
+// as does the encoding of a partial.Partial.
+func Patch() *Schema {
+	return &Schema{
+		Type: Types{Array},
+		Items: &Schema{
+			Type: Types{Object},
+			Properties: map[string]*Schema{
+				"op":    {Type: Types{String}, Enum: []any{"add", "remove", "replace", "move", "copy", "test"}},
+				"path":  {Type: Types{String}, Format: "json-pointer"},
+				"from":  {Type: Types{String}, Format: "json-pointer"},
+				"value": {},
+			},
+			Required: []string{"op", "path"},
+		},
+	}
+}
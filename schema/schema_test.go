@@ -0,0 +1,90 @@
+// Copyright 2022 phelmkamp. All rights reserved.
+// Use of this source code is governed by a MIT
+// license that can be found in the LICENSE file.
+
+package schema_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/binaryphile/valor/enum"
+	"github.com/binaryphile/valor/schema"
+)
+
+type color string
+
+var colors, _ = enum.Of[color, struct{}]("red", "green", "blue")
+
+func init() {
+	colors.Bind()
+}
+
+func Example() {
+	type paint struct {
+		Color enum.Member[color, struct{}] `json:"color"`
+		Gloss float64                      `json:"gloss,omitempty"`
+	}
+
+	b, _ := json.Marshal(schema.For[paint]())
+	fmt.Println(string(b))
+	// Output:
+	// {"type":"object","properties":{"color":{"type":"string","enum":["red","green","blue"]},"gloss":{"type":"number"}},"required":["color"]}
+}
+
+func TestSchemas(t *testing.T) {
+	tests := []struct {
+		name string
+		s    *schema.Schema
+		want string
+	}{
+		{"any", &schema.Schema{}, `{}`},
+		{"types", schema.Of(schema.String, schema.Integer), `{"type":["string","integer"]}`},
+		{"enum", schema.Enum(colors), `{"type":"string","enum":["red","green","blue"]}`},
+		{"optional", schema.Optional(schema.Of(schema.Integer)), `{"type":["integer","null"]}`},
+		{"optional enum", schema.Optional(schema.Enum(colors)), `{"type":["string","null"],"enum":["red","green","blue",null]}`},
+		{"optional any", schema.Optional(&schema.Schema{}), `{}`},
+		{"optional ref", schema.Optional(&schema.Schema{Ref: "#/$defs/node"}), `{"oneOf":[{"$ref":"#/$defs/node"},{"type":"null"}]}`},
+		{
+			"outcome", schema.Outcome(schema.Of(schema.Integer)),
+			`{"oneOf":[` +
+				`{"type":"object","properties":{"value":{"type":"integer"},"warnings":{"type":"array","items":{"type":"string"}}},"additionalProperties":{"not":{}}},` +
+				`{"type":"object","properties":{"error":{"type":"string"},"warnings":{"type":"array","items":{"type":"string"}}},"required":["error"],"additionalProperties":{"not":{}}}]}`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := json.Marshal(tt.s)
+			if err != nil {
+				t.Fatalf("MarshalJSON() error = %v", err)
+			}
+			if string(got) != tt.want {
+				t.Errorf("MarshalJSON() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestOptional_copies(t *testing.T) {
+	s := schema.Enum(colors)
+	schema.Optional(s)
+
+	got, _ := json.Marshal(s)
+	if want := `{"type":"string","enum":["red","green","blue"]}`; string(got) != want {
+		t.Errorf("Optional() modified argument to %s, want %s", got, want)
+	}
+}
+
+func TestPatch(t *testing.T) {
+	s := schema.Patch()
+	if got := s.Type; len(got) != 1 || got[0] != schema.Array {
+		t.Errorf("Patch().Type = %v, want %v", got, schema.Array)
+	}
+	if got := s.Items.Required; len(got) != 2 || got[0] != "op" || got[1] != "path" {
+		t.Errorf("Patch().Items.Required = %v, want %v", got, []string{"op", "path"})
+	}
+	if got := s.Items.Properties["path"].Format; got != "json-pointer" {
+		t.Errorf("Patch() path format = %v, want %v", got, "json-pointer")
+	}
+}
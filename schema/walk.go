@@ -0,0 +1,246 @@
+// Copyright 2022 phelmkamp. All rights reserved.
+// Use of this source code is governed by a MIT
+// license that can be found in the LICENSE file.
+
+package schema
+
+import (
+	"encoding"
+	"encoding/json"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/binaryphile/valor/internal/jsonfields"
+)
+
+// encodedValuer is implemented by types that encode as one of a fixed set of values,
+// such as enum.Member.
+type encodedValuer interface {
+	EncodedValues() []any
+}
+
+// walker builds the Schema of a type, defining recursive struct types in defs.
+type walker struct {
+	defs      map[string]*Schema
+	active    map[reflect.Type]bool
+	recursive map[reflect.Type]bool
+}
+
+const module = "github.com/binaryphile/valor/"
+
+var (
+	encodedValuerType = reflect.TypeOf((*encodedValuer)(nil)).Elem()
+	jsonMarshalerType = reflect.TypeOf((*json.Marshaler)(nil)).Elem()
+	textMarshalerType = reflect.TypeOf((*encoding.TextMarshaler)(nil)).Elem()
+	timeType          = reflect.TypeOf(time.Time{})
+)
+
+// For returns a Schema of the JSON encoding of values of type T.
+func For[T any]() *Schema {
+	return TypeOf(reflect.TypeOf((*T)(nil)).Elem())
+}
+
+// TypeOf returns a Schema of the JSON encoding of values of type t.
+// Struct fields are described following the rules of encoding/json for json tags and embedded structs,
+// including which of several fields of the same name is encoded, if any.
+// A field is required unless it is tagged omitempty or is an optional.Value.
+// Valor types are described by their encodings:
+// an enum.Member or enum.IntMember by the values of the enum bound to its type
+// (see enum.Enum.Bind and enum.Member.EncodedValues),
+// an enum.Set or enum.Flags as an array of such values,
+// an optional.Value as by Optional, a result.Outcome as by Outcome, a result.Result as an empty object
+// and a partial.Partial as by Patch.
+// Tuples are described as the objects they encode as, with members V, V2 and so on.
+// Named struct types that refer to themselves are defined in $defs.
+func TypeOf(t reflect.Type) *Schema {
+	w := walker{
+		defs:      make(map[string]*Schema),
+		active:    make(map[reflect.Type]bool),
+		recursive: make(map[reflect.Type]bool),
+	}
+
+	s := w.walk(t)
+	if len(w.defs) > 0 {
+		root := *s
+		root.Defs = w.defs
+		s = &root
+	}
+
+	return s
+}
+
+// walk returns a Schema of the encoding of values of type t.
+func (w walker) walk(t reflect.Type) *Schema {
+	switch {
+	case t.Kind() == reflect.Pointer:
+		return Optional(w.walk(t.Elem()))
+	case isValor(t, "optional", "Value"):
+		return Optional(w.walk(resultType(t, "Unpack")))
+	case isValor(t, "result", "Outcome"):
+		return Outcome(w.walk(resultType(t, "Unpack")))
+	case isValor(t, "result", "Result"):
+		// its fields are unexported
+		return &Schema{Type: Types{Object}, AdditionalProperties: Never}
+	case isValor(t, "partial", "Partial"):
+		return Patch()
+	case isValor(t, "enum", "Set"), isValor(t, "enum", "Flags"):
+		return w.set(t)
+	case t.Implements(encodedValuerType):
+		return enumerated(reflect.Zero(t).Interface().(encodedValuer).EncodedValues())
+	case t == timeType:
+		return &Schema{Type: Types{String}, Format: "date-time"}
+	case t.Implements(jsonMarshalerType):
+		// the encoding is up to the type
+		return &Schema{}
+	case t.Implements(textMarshalerType):
+		return Of(String)
+	}
+
+	switch t.Kind() {
+	case reflect.Bool:
+		return Of(Boolean)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return Of(Integer)
+	case reflect.Float32, reflect.Float64:
+		return Of(Number)
+	case reflect.String:
+		return Of(String)
+	case reflect.Interface:
+		return &Schema{}
+	case reflect.Slice:
+		if t.Elem().Kind() == reflect.Uint8 && !reflect.PointerTo(t.Elem()).Implements(jsonMarshalerType) {
+			return &Schema{Type: Types{String}, ContentEncoding: "base64"}
+		}
+
+		return &Schema{Type: Types{Array}, Items: w.walk(t.Elem())}
+	case reflect.Array:
+		n := t.Len()
+
+		return &Schema{Type: Types{Array}, Items: w.walk(t.Elem()), MinItems: &n, MaxItems: &n}
+	case reflect.Map:
+		return &Schema{Type: Types{Object}, AdditionalProperties: w.walk(t.Elem())}
+	case reflect.Struct:
+		return w.named(t)
+	}
+
+	// chan, func and complex values don't encode
+	return Never
+}
+
+// named returns a Schema of the struct type t,
+// or a reference to its definition if t refers to itself.
+func (w walker) named(t reflect.Type) *Schema {
+	if t.Name() == "" {
+		return w.object(t)
+	}
+
+	name := t.String()
+	ref := &Schema{Ref: "#/$defs/" + escape(name)}
+
+	if w.active[t] {
+		w.recursive[t] = true
+
+		return ref
+	}
+
+	w.active[t] = true
+	s := w.object(t)
+	delete(w.active, t)
+
+	if !w.recursive[t] {
+		return s
+	}
+
+	w.defs[name] = s
+
+	return ref
+}
+
+// object returns a Schema of the fields of the struct type t.
+func (w walker) object(t reflect.Type) *Schema {
+	s := &Schema{
+		Type:       Types{Object},
+		Properties: make(map[string]*Schema),
+	}
+
+	w.fields(s, t)
+
+	return s
+}
+
+// fields adds the fields of the struct type t, including those promoted from embedded structs, to s.
+// Each name is that of one field, so it is required once at most.
+func (w walker) fields(s *Schema, t reflect.Type) {
+	for _, field := range jsonfields.Of(t) {
+		s.Properties[field.Name] = w.walk(field.Type)
+
+		if !field.OmitEmpty && !isValor(field.Type, "optional", "Value") {
+			s.Required = append(s.Required, field.Name)
+		}
+	}
+}
+
+// set returns a Schema of the enum.Set or enum.Flags type t,
+// an array of the members of its enum.
+func (w walker) set(t reflect.Type) *Schema {
+	return &Schema{
+		Type:        Types{Array},
+		Items:       w.walk(resultType(t, "Members").Elem()),
+		UniqueItems: true,
+	}
+}
+
+// resultType returns the type of the first result of the method name of t,
+// such as the element type of an optional.Value from its Unpack method.
+// Relying on the exported methods of valor types, rather than their fields, keeps the walker independent of their layout.
+func resultType(t reflect.Type, name string) reflect.Type {
+	method, _ := t.MethodByName(name)
+
+	return method.Type.Out(0)
+}
+
+// enumerated returns a Schema that accepts values.
+func enumerated(values []any) *Schema {
+	if values == nil {
+		return &Schema{}
+	}
+
+	var types Types
+
+	seen := make(map[string]bool)
+	for _, value := range values {
+		typ := jsonType(reflect.TypeOf(value))
+		if !seen[typ] {
+			seen[typ] = true
+			types = append(types, typ)
+		}
+	}
+
+	return &Schema{Type: types, Enum: values}
+}
+
+// jsonType returns the JSON type of the encoding of a value of the basic type t.
+func jsonType(t reflect.Type) string {
+	switch t.Kind() {
+	case reflect.Bool:
+		return Boolean
+	case reflect.Float32, reflect.Float64:
+		return Number
+	case reflect.String:
+		return String
+	}
+
+	return Integer
+}
+
+// isValor returns whether t is an instance of the generic type name in the valor package pkg.
+func isValor(t reflect.Type, pkg, name string) bool {
+	return t.PkgPath() == module+pkg && strings.HasPrefix(t.Name(), name+"[")
+}
+
+// escape escapes s for use in a JSON Pointer.
+func escape(s string) string {
+	return strings.NewReplacer("~", "~0", "/", "~1").Replace(s)
+}
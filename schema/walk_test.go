@@ -0,0 +1,196 @@
+// Copyright 2022 phelmkamp. All rights reserved.
+// Use of this source code is governed by a MIT
+// license that can be found in the LICENSE file.
+
+package schema_test
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/binaryphile/valor/enum"
+	"github.com/binaryphile/valor/optional"
+	"github.com/binaryphile/valor/partial"
+	"github.com/binaryphile/valor/result"
+	"github.com/binaryphile/valor/schema"
+	"github.com/binaryphile/valor/tuple/two"
+)
+
+type (
+	base struct {
+		ID      int       `json:"id"`
+		Created time.Time `json:"created"`
+	}
+
+	widget struct {
+		base
+		Name    string                       `json:"name"`
+		Color   enum.Member[color, struct{}] `json:"color"`
+		Colors  enum.Set[color, struct{}]    `json:"colors"`
+		Mode    enum.Flags[color, struct{}]  `json:"mode"`
+		Label   optional.Value[string]       `json:"label"`
+		Total   result.Result[float64]       `json:"total"`
+		Outcome result.Outcome[float64]      `json:"outcome"`
+		Pair    two.Tuple[int, string]       `json:"pair"`
+		Patch   partial.Partial[base]        `json:"patch"`
+		Tags    []string                     `json:"tags,omitempty"`
+		Data    []byte                       `json:"data,omitempty"`
+		Scores  map[string]int               `json:"scores,omitempty"`
+		Point   [2]float64                   `json:"point"`
+		Parent  *base                        `json:"parent"`
+		Extra   any                          `json:"extra,omitempty"`
+		Enabled bool                         `json:"-"`
+		Plain   bool
+		secret  string
+	}
+
+	node struct {
+		Value int    `json:"value"`
+		Next  *node  `json:"next"`
+		Kids  []node `json:"kids,omitempty"`
+	}
+)
+
+func TestTypeOf(t *testing.T) {
+	got := schema.For[widget]()
+
+	wantRequired := []string{"id", "created", "name", "color", "colors", "mode", "total", "outcome", "pair", "patch", "point", "parent", "Plain"}
+	if !reflect.DeepEqual(got.Required, wantRequired) {
+		t.Errorf("Required = %v, want %v", got.Required, wantRequired)
+	}
+
+	tests := []struct {
+		property string
+		want     string
+	}{
+		{"id", `{"type":"integer"}`},
+		{"created", `{"type":"string","format":"date-time"}`},
+		{"name", `{"type":"string"}`},
+		{"color", `{"type":"string","enum":["red","green","blue"]}`},
+		{"colors", `{"type":"array","items":{"type":"string","enum":["red","green","blue"]},"uniqueItems":true}`},
+		{"mode", `{"type":"array","items":{"type":"string","enum":["red","green","blue"]},"uniqueItems":true}`},
+		{"label", `{"type":["string","null"]}`},
+		{"total", `{"type":"object","additionalProperties":{"not":{}}}`},
+		{"outcome", mustMarshal(t, schema.Outcome(schema.Of(schema.Number)))},
+		{"pair", `{"type":"object","properties":{"V":{"type":"integer"},"V2":{"type":"string"}},"required":["V","V2"]}`},
+		{"patch", mustMarshal(t, schema.Patch())},
+		{"tags", `{"type":"array","items":{"type":"string"}}`},
+		{"data", `{"type":"string","contentEncoding":"base64"}`},
+		{"scores", `{"type":"object","additionalProperties":{"type":"integer"}}`},
+		{"point", `{"type":"array","items":{"type":"number"},"minItems":2,"maxItems":2}`},
+		{"parent", `{"type":["object","null"],"properties":{"created":{"type":"string","format":"date-time"},"id":{"type":"integer"}},"required":["id","created"]}`},
+		{"extra", `{}`},
+		{"Plain", `{"type":"boolean"}`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.property, func(t *testing.T) {
+			s, ok := got.Properties[tt.property]
+			if !ok {
+				t.Fatalf("Properties[%q] missing", tt.property)
+			}
+			if got := mustMarshal(t, s); got != tt.want {
+				t.Errorf("Properties[%q] = %s, want %s", tt.property, got, tt.want)
+			}
+		})
+	}
+
+	for _, name := range []string{"base", "Enabled", "-", "secret"} {
+		if _, ok := got.Properties[name]; ok {
+			t.Errorf("Properties[%q] present, want absent", name)
+		}
+	}
+}
+
+func TestTypeOf_int(t *testing.T) {
+	type opcode uint8
+	opcodes, _ := enum.OfInts(
+		enum.IntDef[opcode, struct{}]{Value: 1, Name: "query"},
+		enum.IntDef[opcode, struct{}]{Value: 4, Name: "notify"},
+	)
+	opcodes.Bind()
+
+	if got, want := mustMarshal(t, schema.For[enum.IntMember[opcode, struct{}]]()), `{"type":"integer","enum":[1,4]}`; got != want {
+		t.Errorf("For() = %s, want %s", got, want)
+	}
+
+	opcodes.EncodeNames(true)
+	if got, want := mustMarshal(t, schema.For[enum.IntMember[opcode, struct{}]]()), `{"type":"string","enum":["query","notify"]}`; got != want {
+		t.Errorf("For() with names = %s, want %s", got, want)
+	}
+}
+
+func TestTypeOf_embedded(t *testing.T) {
+	type (
+		inner struct {
+			Name int `json:"name"`
+			Size int `json:"size"`
+		}
+
+		outer struct {
+			Name string `json:"name"`
+			inner
+		}
+
+		// untagged, as go vet rejects equally embedded fields with the same tag
+		a struct {
+			X int
+		}
+
+		b struct {
+			X string
+		}
+
+		conflict struct {
+			a
+			b
+		}
+	)
+
+	tests := []struct {
+		name string
+		got  *schema.Schema
+		want string
+	}{
+		// encoding/json encodes the outer name, {"name":"","size":0}
+		{"shadowed", schema.For[outer](), `{"type":"object","properties":{"name":{"type":"string"},"size":{"type":"integer"}},"required":["name","size"]}`},
+		// encoding/json drops both X fields, {}
+		{"conflicting", schema.For[conflict](), `{"type":"object"}`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := mustMarshal(t, tt.got); got != tt.want {
+				t.Errorf("For() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTypeOf_recursive(t *testing.T) {
+	got := mustMarshal(t, schema.For[node]())
+	want := `{"$ref":"#/$defs/schema_test.node","$defs":{"schema_test.node":{"type":"object","properties":{` +
+		`"kids":{"type":"array","items":{"$ref":"#/$defs/schema_test.node"}},` +
+		`"next":{"oneOf":[{"$ref":"#/$defs/schema_test.node"},{"type":"null"}]},` +
+		`"value":{"type":"integer"}},"required":["value","next"]}}}`
+	if got != want {
+		t.Errorf("For() = %s, want %s", got, want)
+	}
+}
+
+func TestTypeOf_unencodable(t *testing.T) {
+	if got, want := mustMarshal(t, schema.For[func()]()), `{"not":{}}`; got != want {
+		t.Errorf("For() = %s, want %s", got, want)
+	}
+}
+
+func mustMarshal(t *testing.T, s *schema.Schema) string {
+	t.Helper()
+
+	b, err := json.Marshal(s)
+	if err != nil {
+		t.Fatalf("MarshalJSON() error = %v", err)
+	}
+
+	return string(b)
+}
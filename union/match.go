@@ -0,0 +1,79 @@
+// Copyright 2022 phelmkamp. All rights reserved.
+// Use of this source code is governed by a MIT
+// license that can be found in the LICENSE file.
+
+package union
+
+import (
+	"fmt"
+	"reflect"
+
+	"github.com/binaryphile/valor/enum"
+	"github.com/binaryphile/valor/result"
+)
+
+type (
+	// Case handles the payload of one variant.
+	Case[R any, T ~string, A any] struct {
+		tag    enum.Member[T, A]
+		typ    reflect.Type
+		handle func(any) R
+	}
+
+	// Matcher calls the handler for the payload of a Union.
+	// Every variant has a handler.
+	Matcher[R any, T ~string, A any] struct {
+		handlers enum.Map[T, A, func(any) R]
+	}
+)
+
+// On creates a Case in which f handles the payloads of the variant tagged by tag.
+func On[P, R any, T ~string, A any](tag enum.Member[T, A], f func(P) R) Case[R, T, A] {
+	return Case[R, T, A]{
+		tag: tag,
+		typ: reflect.TypeOf((*P)(nil)).Elem(),
+		handle: func(payload any) R {
+			return f(payload.(P))
+		},
+	}
+}
+
+// MatcherOf creates a Matcher of cases, which must have one for every variant.
+// It is an error if a case is missing, repeated or of another enum, as for VariantsOf,
+// or if the type of its payload is not that of its variant, which wraps ErrPayload.
+func MatcherOf[R any, T ~string, A any](variants Variants[T, A], cases ...Case[R, T, A]) result.Result[Matcher[R, T, A]] {
+	handlers := make(map[enum.Member[T, A]]func(any) R, len(cases))
+
+	for _, c := range cases {
+		if _, ok := handlers[c.tag]; ok {
+			return result.OfError[Matcher[R, T, A]](fmt.Errorf("case %q is repeated", c.tag.Name()))
+		}
+
+		if want, ok := variants.types.Lookup(c.tag).Unpack(); ok && c.typ != want {
+			return result.OfError[Matcher[R, T, A]](fmt.Errorf("case %q: %w: got %v, want %v", c.tag.Name(), ErrPayload, c.typ, want))
+		}
+
+		handlers[c.tag] = c.handle
+	}
+
+	return result.Map(func(handlers enum.Map[T, A, func(any) R]) Matcher[R, T, A] {
+		return Matcher[R, T, A]{handlers: handlers}
+	}, enum.MapOf(variants.Enum(), handlers))
+}
+
+// MustMatcherOf is like MatcherOf but panics with the error.
+// This aids in initializing package variables.
+func MustMatcherOf[R any, T ~string, A any](variants Variants[T, A], cases ...Case[R, T, A]) Matcher[R, T, A] {
+	matcher, err := MatcherOf(variants, cases...).Unpack()
+	if err != nil {
+		panic(err)
+	}
+
+	return matcher
+}
+
+// Match calls the handler for the variant of u with its payload and returns its result.
+// It panics with an error wrapping enum.ErrNotMember if u has no handler, such as the zero Union.
+func (x Matcher[R, T, A]) Match(u Union[T, A]) R {
+	return x.handlers.Get(u.tag)(u.payload)
+}
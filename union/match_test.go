@@ -0,0 +1,79 @@
+// Copyright 2022 phelmkamp. All rights reserved.
+// Use of this source code is governed by a MIT
+// license that can be found in the LICENSE file.
+
+package union_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/binaryphile/valor/enum"
+	"github.com/binaryphile/valor/union"
+)
+
+func TestMatcherOf(t *testing.T) {
+	name := func(s string) func(point) string {
+		return func(point) string { return s }
+	}
+
+	tests := []struct {
+		name    string
+		cases   []union.Case[string, kind, struct{}]
+		wantErr error
+	}{
+		{"missing", []union.Case[string, kind, struct{}]{
+			union.On(Circle, func(circle) string { return "circle" }),
+		}, enum.ErrIncomplete},
+		{"wrong payload", []union.Case[string, kind, struct{}]{
+			union.On(Circle, name("circle")),
+			union.On(Rect, func(rect) string { return "rect" }),
+			union.On(Point, name("point")),
+		}, union.ErrPayload},
+		{"repeated", []union.Case[string, kind, struct{}]{
+			union.On(Point, name("point")),
+			union.On(Point, name("point")),
+		}, nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := union.MatcherOf(Shapes, tt.cases...).Error()
+			if err == nil || tt.wantErr != nil && !errors.Is(err, tt.wantErr) {
+				t.Errorf("MatcherOf() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestMatcher_Match(t *testing.T) {
+	describe := union.MustMatcherOf(Shapes,
+		union.On(Circle, func(c circle) string { return "circle" }),
+		union.On(Rect, func(r rect) string { return "rect" }),
+		union.On(Point, func(point) string { return "point" }),
+	)
+
+	for _, s := range []shape{
+		Shapes.Of(Circle, circle{}).Value().MustOk(),
+		Shapes.Of(Rect, rect{}).Value().MustOk(),
+		Shapes.Of(Point, point{}).Value().MustOk(),
+	} {
+		if got := describe.Match(s); got != s.Tag().Name() {
+			t.Errorf("Match(%v) = %v, want %v", s, got, s.Tag().Name())
+		}
+	}
+}
+
+func TestMatcher_Match_zero(t *testing.T) {
+	describe := union.MustMatcherOf(Shapes,
+		union.On(Circle, func(circle) string { return "circle" }),
+		union.On(Rect, func(rect) string { return "rect" }),
+		union.On(Point, func(point) string { return "point" }),
+	)
+
+	defer func() {
+		if err, _ := recover().(error); !errors.Is(err, enum.ErrNotMember) {
+			t.Errorf("Match() of zero Union panic = %v, want %v", err, enum.ErrNotMember)
+		}
+	}()
+	describe.Match(shape{})
+}
@@ -0,0 +1,261 @@
+// Copyright 2022 phelmkamp. All rights reserved.
+// Use of this source code is governed by a MIT
+// license that can be found in the LICENSE file.
+
+package union
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+
+	"github.com/binaryphile/valor/enum"
+	"github.com/binaryphile/valor/internal/binding"
+	"github.com/binaryphile/valor/optional"
+	"github.com/binaryphile/valor/result"
+)
+
+// ErrPayload is the error for a payload whose type is not that of its variant.
+var ErrPayload = errors.New("payload does not match the variant")
+
+type (
+	// Variant binds a member of an enum, its tag, to the Go type of its payload.
+	Variant[T ~string, A any] struct {
+		tag enum.Member[T, A]
+		typ reflect.Type
+	}
+
+	// Variants are the variants of a Union, one for each member of an enum.
+	Variants[T ~string, A any] struct {
+		types         enum.Map[T, A, reflect.Type]
+		discriminator string
+	}
+
+	// Union holds a payload of one of its variants along with the tag of that variant.
+	// The zero Union has neither.
+	Union[T ~string, A any] struct {
+		variants Variants[T, A]
+		tag      enum.Member[T, A]
+		payload  any
+	}
+)
+
+// VariantOf creates a Variant that binds tag to payloads of type P.
+// P is usually a struct, so its JSON encoding is an object; see Union.MarshalJSON.
+func VariantOf[P any, T ~string, A any](tag enum.Member[T, A]) Variant[T, A] {
+	return Variant[T, A]{
+		tag: tag,
+		typ: reflect.TypeOf((*P)(nil)).Elem(),
+	}
+}
+
+// VariantsOf creates the Variants of a Union, which must have one for every member of e.
+// In JSON, the tag of the Union is the member named discriminator, such as "type".
+// It is an error if a variant is missing, repeated or of another enum;
+// see enum.MapOf for the errors.
+func VariantsOf[T ~string, A any](e enum.Enum[T, A], discriminator string, variants ...Variant[T, A]) result.Result[Variants[T, A]] {
+	types := make(map[enum.Member[T, A]]reflect.Type, len(variants))
+
+	for _, variant := range variants {
+		if _, ok := types[variant.tag]; ok {
+			return result.OfError[Variants[T, A]](fmt.Errorf("variant %q is repeated", variant.tag.Name()))
+		}
+
+		types[variant.tag] = variant.typ
+	}
+
+	return result.Map(func(types enum.Map[T, A, reflect.Type]) Variants[T, A] {
+		return Variants[T, A]{
+			types:         types,
+			discriminator: discriminator,
+		}
+	}, enum.MapOf(e, types))
+}
+
+// MustVariantsOf is like VariantsOf but panics with the error.
+// This aids in initializing package variables.
+func MustVariantsOf[T ~string, A any](e enum.Enum[T, A], discriminator string, variants ...Variant[T, A]) Variants[T, A] {
+	x, err := VariantsOf(e, discriminator, variants...).Unpack()
+	if err != nil {
+		panic(err)
+	}
+
+	return x
+}
+
+// Bind makes x the Variants used to decode zero Unions of their type,
+// replacing any Variants bound before, as enum.Enum.Bind does for Members.
+func (x Variants[T, A]) Bind() {
+	binding.Bind[Union[T, A]](x)
+}
+
+// Of returns a Result of a Union of payload tagged by tag.
+// It is an error if tag is not a variant of x, which wraps enum.ErrNotMember,
+// or if payload is not of the type bound to tag, which wraps ErrPayload.
+func (x Variants[T, A]) Of(tag enum.Member[T, A], payload any) result.Result[Union[T, A]] {
+	want, err := x.typeOf(tag).Unpack()
+	if err != nil {
+		return result.OfError[Union[T, A]](err)
+	}
+
+	if reflect.TypeOf(payload) != want {
+		return result.OfError[Union[T, A]](fmt.Errorf("%q: %w: got %T, want %v", tag.Name(), ErrPayload, payload, want))
+	}
+
+	return result.OfOk(Union[T, A]{
+		variants: x,
+		tag:      tag,
+		payload:  payload,
+	})
+}
+
+// Enum returns the enum whose members are the tags of x.
+func (x Variants[T, A]) Enum() enum.Enum[T, A] {
+	return x.types.Enum()
+}
+
+// Discriminator returns the name of the JSON member that holds the tag.
+func (x Variants[_, _]) Discriminator() string {
+	return x.discriminator
+}
+
+// Tag returns the tag of x.
+func (x Union[T, A]) Tag() enum.Member[T, A] {
+	return x.tag
+}
+
+// Payload returns the payload of x.
+func (x Union[_, _]) Payload() any {
+	return x.payload
+}
+
+// String returns x formatted as a string.
+func (x Union[_, _]) String() string {
+	return fmt.Sprintf("{%v %v}", x.tag, x.payload)
+}
+
+// As returns an optional.Value of the payload of x if it is of type P.
+func As[P any, T ~string, A any](x Union[T, A]) optional.Value[P] {
+	payload, ok := x.payload.(P)
+
+	return optional.Of(payload, ok)
+}
+
+// MarshalJSON encodes x as the JSON object of its payload
+// with the discriminator member first, holding the name of its tag.
+// It is an error if the payload does not encode as an object or already has a discriminator member.
+// The zero Union encodes as the literal null.
+func (x Union[T, A]) MarshalJSON() ([]byte, error) {
+	if x.tag == (enum.Member[T, A]{}) {
+		return []byte("null"), nil
+	}
+
+	data, err := json.Marshal(x.payload)
+	if err != nil {
+		return nil, err
+	}
+
+	var obj map[string]json.RawMessage
+	if err := json.Unmarshal(data, &obj); err != nil || obj == nil {
+		return nil, fmt.Errorf("%q: payload %T does not encode as an object", x.tag.Name(), x.payload)
+	}
+
+	if _, ok := obj[x.variants.discriminator]; ok {
+		return nil, fmt.Errorf("%q: payload %T has a member named %q", x.tag.Name(), x.payload, x.variants.discriminator)
+	}
+
+	member, err := json.Marshal(map[string]enum.Member[T, A]{x.variants.discriminator: x.tag})
+	if err != nil {
+		return nil, err
+	}
+
+	if len(obj) == 0 {
+		return member, nil
+	}
+
+	// join {"type":"tag"} and {...} as {"type":"tag",...}
+	return append(append(member[:len(member)-1], ','), data[1:]...), nil
+}
+
+// UnmarshalJSON decodes an object into x.
+// The discriminator member names the tag, as for enum.Enum.Parse,
+// and the object is decoded into a payload of the type bound to the tag.
+// If x is not zero, its Variants are used.
+// Otherwise the Variants bound to the type of x are.
+// Does nothing if data is the literal null.
+func (x *Union[T, A]) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		// by convention, null is no-op
+		return nil
+	}
+
+	variants, err := x.decodingVariants().Unpack()
+	if err != nil {
+		return err
+	}
+
+	var obj map[string]json.RawMessage
+	if err := json.Unmarshal(data, &obj); err != nil {
+		return err
+	}
+
+	raw, ok := obj[variants.discriminator]
+	if !ok {
+		return fmt.Errorf("missing discriminator %q", variants.discriminator)
+	}
+
+	var name string
+	if err := json.Unmarshal(raw, &name); err != nil {
+		return fmt.Errorf("discriminator %q: %w", variants.discriminator, err)
+	}
+
+	tag, err := variants.Enum().Parse(name).Unpack()
+	if err != nil {
+		return err
+	}
+
+	typ, err := variants.typeOf(tag).Unpack()
+	if err != nil {
+		return err
+	}
+
+	payload := reflect.New(typ)
+	if err := json.Unmarshal(data, payload.Interface()); err != nil {
+		return fmt.Errorf("%q: %w", name, err)
+	}
+
+	*x = Union[T, A]{
+		variants: variants,
+		tag:      tag,
+		payload:  payload.Elem().Interface(),
+	}
+
+	return nil
+}
+
+// typeOf returns a Result of the payload type bound to tag.
+// It is an error if tag is not a variant of x,
+// because it is the zero Member, is of another enum or was added to the enum after x was created.
+func (x Variants[T, A]) typeOf(tag enum.Member[T, A]) result.Result[reflect.Type] {
+	typ, ok := x.types.Lookup(tag).Unpack()
+	if !ok {
+		return result.OfError[reflect.Type](fmt.Errorf("%q: %w", tag.Name(), enum.ErrNotMember))
+	}
+
+	return result.OfOk(typ)
+}
+
+// decodingVariants returns the Variants of x if it is not zero, otherwise those bound to its type.
+func (x Union[T, A]) decodingVariants() result.Result[Variants[T, A]] {
+	if x.tag != (enum.Member[T, A]{}) {
+		return result.OfOk(x.variants)
+	}
+
+	variants, ok := binding.Bound[Union[T, A], Variants[T, A]]().Unpack()
+	if !ok {
+		return result.OfError[Variants[T, A]](fmt.Errorf("no Variants bound to %v; call Bind on one", binding.TypeOf[Union[T, A]]()))
+	}
+
+	return result.OfOk(variants)
+}
@@ -0,0 +1,229 @@
+// Copyright 2022 phelmkamp. All rights reserved.
+// Use of this source code is governed by a MIT
+// license that can be found in the LICENSE file.
+
+package union_test
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/binaryphile/valor/enum"
+	"github.com/binaryphile/valor/union"
+)
+
+type (
+	kind string
+
+	circle struct {
+		Radius float64 `json:"radius"`
+	}
+
+	rect struct {
+		Width  float64 `json:"width"`
+		Height float64 `json:"height"`
+	}
+
+	point struct{}
+
+	shape = union.Union[kind, struct{}]
+)
+
+var (
+	Kinds, _ = enum.Of[kind, struct{}]("circle", "rect", "point")
+
+	Circle = Kinds.Member("circle").MustOk()
+	Rect   = Kinds.Member("rect").MustOk()
+	Point  = Kinds.Member("point").MustOk()
+
+	Shapes = union.MustVariantsOf(Kinds, "kind",
+		union.VariantOf[circle](Circle),
+		union.VariantOf[rect](Rect),
+		union.VariantOf[point](Point),
+	)
+)
+
+func init() {
+	// zero Unions decode with the bound Variants
+	Shapes.Bind()
+}
+
+func Example() {
+	area := union.MustMatcherOf(Shapes,
+		union.On(Circle, func(c circle) float64 { return 3 * c.Radius * c.Radius }),
+		union.On(Rect, func(r rect) float64 { return r.Width * r.Height }),
+		union.On(Point, func(point) float64 { return 0 }),
+	)
+
+	var shapes []shape
+	_ = json.Unmarshal([]byte(`[{"kind":"circle","radius":1},{"kind":"rect","width":2,"height":3}]`), &shapes)
+
+	for _, s := range shapes {
+		fmt.Println(s.Tag(), area.Match(s))
+	}
+
+	b, _ := json.Marshal(shapes)
+	fmt.Println(string(b))
+	// Output:
+	// circle 3
+	// rect 6
+	// [{"kind":"circle","radius":1},{"kind":"rect","width":2,"height":3}]
+}
+
+func TestVariantsOf(t *testing.T) {
+	others, _ := enum.Of[kind, struct{}]("circle")
+
+	tests := []struct {
+		name     string
+		variants []union.Variant[kind, struct{}]
+		wantErr  error
+	}{
+		{"missing", []union.Variant[kind, struct{}]{union.VariantOf[circle](Circle)}, enum.ErrIncomplete},
+		{"foreign", []union.Variant[kind, struct{}]{
+			union.VariantOf[circle](others.Member("circle").MustOk()),
+			union.VariantOf[circle](Circle),
+			union.VariantOf[rect](Rect),
+			union.VariantOf[point](Point),
+		}, enum.ErrNotMember},
+		{"repeated", []union.Variant[kind, struct{}]{
+			union.VariantOf[circle](Circle),
+			union.VariantOf[rect](Circle),
+		}, nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := union.VariantsOf(Kinds, "kind", tt.variants...).Error()
+			if err == nil || tt.wantErr != nil && !errors.Is(err, tt.wantErr) {
+				t.Errorf("VariantsOf() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestVariants_Of(t *testing.T) {
+	s, err := Shapes.Of(Rect, rect{Width: 2, Height: 3}).Unpack()
+	if err != nil {
+		t.Fatalf("Of() error = %v", err)
+	}
+	if s.Tag() != Rect {
+		t.Errorf("Tag() = %v, want %v", s.Tag(), Rect)
+	}
+	if got := union.As[rect](s); got.MustOk() != (rect{Width: 2, Height: 3}) {
+		t.Errorf("As[rect]() = %v, want %v", got, rect{Width: 2, Height: 3})
+	}
+	if got := union.As[circle](s); got.IsOk() {
+		t.Errorf("As[circle]() = %v, want not ok", got)
+	}
+
+	if err := Shapes.Of(Rect, circle{}).Error(); !errors.Is(err, union.ErrPayload) {
+		t.Errorf("Of() error = %v, want %v", err, union.ErrPayload)
+	}
+	if err := Shapes.Of(Rect, &rect{}).Error(); !errors.Is(err, union.ErrPayload) {
+		t.Errorf("Of() error = %v, want %v", err, union.ErrPayload)
+	}
+
+	if err := Shapes.Of(enum.Member[kind, struct{}]{}, point{}).Error(); !errors.Is(err, enum.ErrNotMember) {
+		t.Errorf("Of() of zero tag error = %v, want %v", err, enum.ErrNotMember)
+	}
+
+	others, _ := enum.Of[kind, struct{}]("rect")
+	if err := Shapes.Of(others.Member("rect").MustOk(), rect{}).Error(); !errors.Is(err, enum.ErrNotMember) {
+		t.Errorf("Of() error = %v, want %v", err, enum.ErrNotMember)
+	}
+}
+
+func TestUnion_MarshalJSON(t *testing.T) {
+	tests := []struct {
+		name string
+		s    shape
+		want string
+	}{
+		{"object", Shapes.Of(Circle, circle{Radius: 2}).Value().MustOk(), `{"kind":"circle","radius":2}`},
+		{"empty object", Shapes.Of(Point, point{}).Value().MustOk(), `{"kind":"point"}`},
+		{"zero", shape{}, `null`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := json.Marshal(tt.s)
+			if err != nil {
+				t.Fatalf("MarshalJSON() error = %v", err)
+			}
+			if string(got) != tt.want {
+				t.Errorf("MarshalJSON() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestUnion_MarshalJSON_errors(t *testing.T) {
+	type (
+		label string
+
+		tagged struct {
+			Kind string `json:"kind"`
+		}
+	)
+	kinds, _ := enum.Of[kind, bool]("label", "tagged")
+	variants := union.MustVariantsOf(kinds, "kind",
+		union.VariantOf[label](kinds.Member("label").MustOk()),
+		union.VariantOf[tagged](kinds.Member("tagged").MustOk()),
+	)
+
+	for _, u := range []union.Union[kind, bool]{
+		variants.Of(kinds.Member("label").MustOk(), label("x")).Value().MustOk(),
+		variants.Of(kinds.Member("tagged").MustOk(), tagged{Kind: "x"}).Value().MustOk(),
+	} {
+		if _, err := json.Marshal(u); err == nil {
+			t.Errorf("MarshalJSON(%v) error = %v, want error", u, err)
+		}
+	}
+}
+
+func TestVariants_Bind(t *testing.T) {
+	kinds, _ := enum.Of[kind, int]("circle")
+	variants := union.MustVariantsOf(kinds, "kind", union.VariantOf[circle](kinds.Member("circle").MustOk()))
+
+	var got union.Union[kind, int]
+	if err := json.Unmarshal([]byte(`{"kind":"circle"}`), &got); err == nil {
+		t.Errorf("UnmarshalJSON() without Bind() error = %v, want error", err)
+	}
+
+	variants.Bind()
+	if err := json.Unmarshal([]byte(`{"kind":"circle","radius":2}`), &got); err != nil || union.As[circle](got).MustOk() != (circle{Radius: 2}) {
+		t.Errorf("UnmarshalJSON() after Bind() = %v %v, want %v", got, err, circle{Radius: 2})
+	}
+}
+
+func TestUnion_UnmarshalJSON(t *testing.T) {
+	var got shape
+	if err := json.Unmarshal([]byte(`{"radius":1.5,"kind":"circle"}`), &got); err != nil {
+		t.Fatalf("UnmarshalJSON() error = %v", err)
+	}
+	if c := union.As[circle](got); got.Tag() != Circle || c.MustOk() != (circle{Radius: 1.5}) {
+		t.Errorf("UnmarshalJSON() = %v, want %v", got, circle{Radius: 1.5})
+	}
+
+	if err := json.Unmarshal([]byte(`null`), &got); err != nil || got.Tag() != Circle {
+		t.Errorf("UnmarshalJSON(null) = %v %v, want unchanged", got, err)
+	}
+
+	for _, data := range []string{
+		`{"radius":1}`,
+		`{"kind":"hexagon"}`,
+		`{"kind":1}`,
+		`{"kind":"rect","width":"wide"}`,
+		`[]`,
+	} {
+		var got shape
+		if err := json.Unmarshal([]byte(data), &got); err == nil {
+			t.Errorf("UnmarshalJSON(%s) = %v, want error", data, got)
+		}
+	}
+
+	var unbound union.Union[kind, string]
+	if err := json.Unmarshal([]byte(`{"kind":"circle"}`), &unbound); err == nil {
+		t.Errorf("UnmarshalJSON() without Variants error = %v, want error", err)
+	}
+}